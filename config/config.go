@@ -4,13 +4,22 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
-	Postgres    PostgresConfig
-	WS          WSConfig
-	HTTP        HTTPConfig
-	StorageType string
+	Postgres      PostgresConfig
+	WS            WSConfig
+	HTTP          HTTPConfig
+	Auth          AuthConfig
+	GraphQL       GraphQLConfig
+	Comments      CommentsConfig
+	Pagination    PaginationConfig
+	CommentBus    CommentBusConfig
+	Outbox        OutboxConfig
+	Subscriptions SubscriptionsConfig
+	Shutdown      ShutdownConfig
+	StorageType   string
 }
 
 type PostgresConfig struct {
@@ -40,6 +49,64 @@ type HTTPConfig struct {
 
 type WSConfig struct {
 	KeepAliveSeconds int
+	// AllowedOrigins — Origin, с которых браузер может открыть websocket
+	AllowedOrigins []string
+}
+
+type AuthConfig struct {
+	JWTSecret string
+	// LegacyUserIDArgs разрешает брать пользователя из аргумента userId, если токена нет.
+	LegacyUserIDArgs bool
+}
+
+type GraphQLConfig struct {
+	// LegacyNumericIDs разрешает клиентам передавать числовые ID постов и комментариев вместо глобальных.
+	LegacyNumericIDs bool
+	// Transports — включенные транспорты /query: post, get, sse, websocket
+	Transports []string
+}
+
+type PaginationConfig struct {
+	// CursorSecrets — секреты подписи курсоров
+	CursorSecrets []string
+}
+
+type CommentBusConfig struct {
+	// Type — inmemory (одна реплика) или postgres (LISTEN/NOTIFY, нужен STORAGE_TYPE=postgres)
+	Type string
+	// Channel — канал NOTIFY, пусто — значение по умолчанию шины
+	Channel string
+	// BufferSize — очередь событий одного подписчика, 0 — значение по умолчанию шины
+	BufferSize int
+	// SlowPolicy — drop-oldest, drop-newest или disconnect при заполненной очереди
+	SlowPolicy string
+}
+
+type OutboxConfig struct {
+	// PollIntervalMS — пауза между опросами пустого outbox, 0 — значение по умолчанию сервиса
+	PollIntervalMS int
+	BatchSize      int
+	// MaxAttempts — после стольких неудачных доставок событие отбрасывается
+	MaxAttempts int
+}
+
+type SubscriptionsConfig struct {
+	// MaxPerConnection — одновременных подписок на websocket-соединении, 0 — без ограничения
+	MaxPerConnection int
+	// MaxPerUser — одновременных подписок пользователя по всем соединениям, 0 — без ограничения
+	MaxPerUser int
+}
+
+type ShutdownConfig struct {
+	// ReadinessDelaySeconds — сколько /readyz отвечает 503 до начала остановки
+	ReadinessDelaySeconds int
+	// GracePeriodSeconds — время на завершение подписок, запросов и воркеров
+	GracePeriodSeconds int
+}
+
+type CommentsConfig struct {
+	// MaxDepth — максимальная глубина вложенности ответов, 0 — значение по умолчанию сервиса
+	MaxDepth int
 }
 
 func LoadConfig() Config {
@@ -52,6 +119,40 @@ func LoadConfig() Config {
 		},
 		WS: WSConfig{
 			KeepAliveSeconds: mustGetInt("WS_KEEPALIVE_SECONDS"),
+			AllowedOrigins:   getList("WS_ALLOWED_ORIGINS", nil),
+		},
+		Auth: AuthConfig{
+			JWTSecret:        mustGetEnv("AUTH_JWT_SECRET"),
+			LegacyUserIDArgs: getBool("AUTH_LEGACY_USER_ID", false),
+		},
+		GraphQL: GraphQLConfig{
+			LegacyNumericIDs: getBool("GRAPHQL_LEGACY_NUMERIC_IDS", false),
+			Transports:       getList("GRAPHQL_TRANSPORTS", []string{"post", "get", "sse", "websocket"}),
+		},
+		Comments: CommentsConfig{
+			MaxDepth: getInt("COMMENTS_MAX_DEPTH", 0),
+		},
+		Pagination: PaginationConfig{
+			CursorSecrets: mustGetList("PAGINATION_CURSOR_SECRETS"),
+		},
+		CommentBus: CommentBusConfig{
+			Type:       getEnv("COMMENT_BUS_TYPE", "inmemory"),
+			Channel:    os.Getenv("COMMENT_BUS_CHANNEL"),
+			BufferSize: getInt("COMMENT_BUS_BUFFER", 0),
+			SlowPolicy: getEnv("COMMENT_BUS_SLOW_POLICY", "drop-oldest"),
+		},
+		Outbox: OutboxConfig{
+			PollIntervalMS: getInt("OUTBOX_POLL_INTERVAL_MS", 0),
+			BatchSize:      getInt("OUTBOX_BATCH_SIZE", 0),
+			MaxAttempts:    getInt("OUTBOX_MAX_ATTEMPTS", 0),
+		},
+		Subscriptions: SubscriptionsConfig{
+			MaxPerConnection: getInt("SUBSCRIPTIONS_MAX_PER_CONNECTION", 20),
+			MaxPerUser:       getInt("SUBSCRIPTIONS_MAX_PER_USER", 100),
+		},
+		Shutdown: ShutdownConfig{
+			ReadinessDelaySeconds: getInt("SHUTDOWN_READINESS_DELAY_SECONDS", 0),
+			GracePeriodSeconds:    getInt("SHUTDOWN_GRACE_SECONDS", 10),
 		},
 	}
 
@@ -77,6 +178,13 @@ func mustGetEnv(key string) string {
 	return val
 }
 
+func getEnv(key, def string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return def
+}
+
 func mustGetInt(key string) int {
 	val := mustGetEnv(key)
 	i, err := strconv.Atoi(val)
@@ -85,3 +193,54 @@ func mustGetInt(key string) int {
 	}
 	return i
 }
+
+// mustGetList разбирает список через запятую, пустые элементы отбрасываются.
+func mustGetList(key string) []string {
+	out := splitList(mustGetEnv(key))
+	if len(out) == 0 {
+		panic("empty list in env var " + key)
+	}
+	return out
+}
+
+func getList(key string, def []string) []string {
+	out := splitList(os.Getenv(key))
+	if len(out) == 0 {
+		return def
+	}
+	return out
+}
+
+func splitList(val string) []string {
+	var out []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func getInt(key string, def int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		panic("invalid int for env var " + key + ": " + val)
+	}
+	return i
+}
+
+func getBool(key string, def bool) bool {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		panic("invalid bool for env var " + key + ": " + val)
+	}
+	return b
+}
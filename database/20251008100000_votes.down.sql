@@ -0,0 +1,9 @@
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS downvotes,
+    DROP COLUMN IF EXISTS upvotes;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS downvotes,
+    DROP COLUMN IF EXISTS upvotes;
+
+DROP TABLE IF EXISTS votes;
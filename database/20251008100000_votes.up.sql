@@ -0,0 +1,16 @@
+CREATE TABLE votes (
+    user_id     BIGINT   NOT NULL,
+    target_type TEXT     NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id   BIGINT   NOT NULL,
+    value       SMALLINT NOT NULL CHECK (value IN (-1, 0, 1)),
+    PRIMARY KEY (user_id, target_type, target_id)
+);
+
+-- денормализованные счетчики, обновляются в одной транзакции с votes
+ALTER TABLE posts
+    ADD COLUMN upvotes   BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN downvotes BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE comments
+    ADD COLUMN upvotes   BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN downvotes BIGINT NOT NULL DEFAULT 0;
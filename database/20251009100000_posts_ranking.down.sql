@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_posts_controversial;
+DROP INDEX IF EXISTS idx_posts_top;
+DROP INDEX IF EXISTS idx_posts_hot;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS controversy,
+    DROP COLUMN IF EXISTS hot_rank,
+    DROP COLUMN IF EXISTS score;
+
+DROP FUNCTION IF EXISTS ranking_controversy(BIGINT, BIGINT);
+DROP FUNCTION IF EXISTS ranking_hot(BIGINT, BIGINT, TIMESTAMP);
@@ -0,0 +1,31 @@
+-- формулы совпадают с pkg/ranking; IMMUTABLE нужен для generated-колонок.
+-- ranking_hot принимает время в UTC без зоны: extract(epoch) от timestamptz
+-- только STABLE, а от timestamp — IMMUTABLE
+CREATE FUNCTION ranking_hot(ups BIGINT, downs BIGINT, created_at TIMESTAMP)
+    RETURNS DOUBLE PRECISION
+    LANGUAGE SQL IMMUTABLE AS
+$$
+    SELECT (sign(ups - downs) * log(greatest(abs(ups - downs), 1))
+        + (extract(EPOCH FROM created_at) - 1134028003) / 45000)::DOUBLE PRECISION
+$$;
+
+CREATE FUNCTION ranking_controversy(ups BIGINT, downs BIGINT)
+    RETURNS DOUBLE PRECISION
+    LANGUAGE SQL IMMUTABLE AS
+$$
+    SELECT CASE
+        WHEN ups <= 0 OR downs <= 0 THEN 0
+        ELSE power((ups + downs)::DOUBLE PRECISION,
+                   least(ups, downs)::DOUBLE PRECISION / greatest(ups, downs))
+    END
+$$;
+
+ALTER TABLE posts
+    ADD COLUMN score       BIGINT GENERATED ALWAYS AS (upvotes - downvotes) STORED,
+    ADD COLUMN hot_rank    DOUBLE PRECISION GENERATED ALWAYS AS (ranking_hot(upvotes, downvotes, created_at AT TIME ZONE 'UTC')) STORED,
+    ADD COLUMN controversy DOUBLE PRECISION GENERATED ALWAYS AS (ranking_controversy(upvotes, downvotes)) STORED;
+
+-- индексы под keyset-пагинацию каждой сортировки
+CREATE INDEX idx_posts_hot ON posts (hot_rank DESC, id DESC);
+CREATE INDEX idx_posts_top ON posts (score DESC, id DESC);
+CREATE INDEX idx_posts_controversial ON posts (controversy DESC, id DESC);
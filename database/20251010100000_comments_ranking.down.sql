@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_comments_controversial;
+DROP INDEX IF EXISTS idx_comments_top;
+DROP INDEX IF EXISTS idx_comments_best;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS controversy,
+    DROP COLUMN IF EXISTS best_rank,
+    DROP COLUMN IF EXISTS score;
+
+DROP FUNCTION IF EXISTS ranking_wilson(BIGINT, BIGINT);
@@ -0,0 +1,23 @@
+-- формула совпадает с ranking.Wilson (z для доверия 80%)
+CREATE FUNCTION ranking_wilson(ups BIGINT, downs BIGINT)
+    RETURNS DOUBLE PRECISION
+    LANGUAGE SQL IMMUTABLE AS
+$$
+    SELECT CASE
+        WHEN ups + downs <= 0 THEN 0
+        ELSE (p + z * z / (2 * n) - z * sqrt((p * (1 - p) + z * z / (4 * n)) / n)) / (1 + z * z / n)
+    END
+    FROM (SELECT ups::DOUBLE PRECISION / (ups + downs) AS p,
+                 (ups + downs)::DOUBLE PRECISION       AS n,
+                 1.281551565545::DOUBLE PRECISION      AS z) AS w
+$$;
+
+ALTER TABLE comments
+    ADD COLUMN score       BIGINT GENERATED ALWAYS AS (upvotes - downvotes) STORED,
+    ADD COLUMN best_rank   DOUBLE PRECISION GENERATED ALWAYS AS (ranking_wilson(upvotes, downvotes)) STORED,
+    ADD COLUMN controversy DOUBLE PRECISION GENERATED ALWAYS AS (ranking_controversy(upvotes, downvotes)) STORED;
+
+-- индексы под keyset-пагинацию каждой сортировки внутри поста
+CREATE INDEX idx_comments_best ON comments (post_id, parent_id, best_rank DESC, id DESC);
+CREATE INDEX idx_comments_top ON comments (post_id, parent_id, score DESC, id DESC);
+CREATE INDEX idx_comments_controversial ON comments (post_id, parent_id, controversy DESC, id DESC);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_comments_reply_count ON comments;
+DROP FUNCTION IF EXISTS comments_inc_reply_count();
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS reply_count;
@@ -0,0 +1,28 @@
+ALTER TABLE comments
+    ADD COLUMN reply_count BIGINT NOT NULL DEFAULT 0;
+
+UPDATE comments c
+SET reply_count = r.cnt
+FROM (SELECT parent_id, count(*) AS cnt
+      FROM comments
+      WHERE parent_id IS NOT NULL
+      GROUP BY parent_id) AS r
+WHERE c.id = r.parent_id;
+
+-- счетчик прямых ответов ведет триггер, чтобы вставка ответа и инкремент
+-- родителя были атомарны; комментарии удаляются мягко, поэтому декремента нет
+CREATE FUNCTION comments_inc_reply_count()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    UPDATE comments SET reply_count = reply_count + 1 WHERE id = NEW.parent_id;
+    RETURN NULL;
+END
+$$;
+
+CREATE TRIGGER trg_comments_reply_count
+    AFTER INSERT ON comments
+    FOR EACH ROW
+    WHEN (NEW.parent_id IS NOT NULL)
+    EXECUTE FUNCTION comments_inc_reply_count();
@@ -0,0 +1,22 @@
+-- path — id предков от корня до родителя, depth — глубина (у корневых 0)
+ALTER TABLE comments
+    ADD COLUMN path  BIGINT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN depth INT      NOT NULL DEFAULT 0;
+
+WITH RECURSIVE tree AS (
+    SELECT id, ARRAY[]::BIGINT[] AS path
+    FROM comments
+    WHERE parent_id IS NULL
+    UNION ALL
+    SELECT c.id, t.path || c.parent_id
+    FROM comments c
+    JOIN tree t ON c.parent_id = t.id
+)
+UPDATE comments c
+SET path  = tree.path,
+    depth = cardinality(tree.path)
+FROM tree
+WHERE c.id = tree.id;
+
+-- поиск поддерева: WHERE path @> ARRAY[id]
+CREATE INDEX idx_comments_path ON comments USING GIN (path);
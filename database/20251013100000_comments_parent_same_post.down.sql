@@ -0,0 +1,5 @@
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_parent_same_post_fkey;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_post_id_id_key;
@@ -0,0 +1,8 @@
+-- ответ должен ссылаться на комментарий того же поста: составной внешний ключ
+-- (post_id, parent_id) -> (post_id, id); для корневых parent_id NULL и проверка не выполняется
+ALTER TABLE comments
+    ADD CONSTRAINT comments_post_id_id_key UNIQUE (post_id, id);
+
+ALTER TABLE comments
+    ADD CONSTRAINT comments_parent_same_post_fkey
+    FOREIGN KEY (post_id, parent_id) REFERENCES comments (post_id, id) ON DELETE CASCADE;
@@ -0,0 +1,14 @@
+-- события, записанные в одной транзакции с изменением данных; релей доставляет их
+-- в шину комментариев и удаляет
+CREATE TABLE outbox (
+    id              BIGSERIAL   PRIMARY KEY,
+    topic           TEXT        NOT NULL,
+    post_id         BIGINT      NOT NULL,
+    comment_id      BIGINT      NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_outbox_due ON outbox (next_attempt_at, id);
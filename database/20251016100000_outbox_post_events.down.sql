@@ -0,0 +1,2 @@
+DELETE FROM outbox WHERE comment_id IS NULL;
+ALTER TABLE outbox ALTER COLUMN comment_id SET NOT NULL;
@@ -0,0 +1,2 @@
+-- события постов не ссылаются на комментарий
+ALTER TABLE outbox ALTER COLUMN comment_id DROP NOT NULL;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_votes_delete_comments ON comments;
+DROP TRIGGER IF EXISTS trg_votes_delete_posts ON posts;
+DROP FUNCTION IF EXISTS votes_delete_comments();
+DROP FUNCTION IF EXISTS votes_delete_posts();
+DROP INDEX IF EXISTS votes_target_idx;
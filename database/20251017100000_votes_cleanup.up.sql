@@ -0,0 +1,41 @@
+-- у votes нет внешнего ключа (цель полиморфная), поэтому голоса удаленных
+-- постов и комментариев чистят триггеры. Мягко удаленный комментарий голоса
+-- сохраняет, чтобы они сходились со счетчиками; новые голоса за него отклоняются
+CREATE INDEX votes_target_idx ON votes (target_type, target_id);
+
+DELETE FROM votes v
+WHERE (v.target_type = 'post' AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = v.target_id))
+   OR (v.target_type = 'comment' AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = v.target_id));
+
+CREATE FUNCTION votes_delete_posts()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    DELETE FROM votes v USING deleted_posts d WHERE v.target_type = 'post' AND v.target_id = d.id;
+    RETURN NULL;
+END
+$$;
+
+-- комментарии удаленного поста уходят каскадом и попадают в этот же триггер
+CREATE FUNCTION votes_delete_comments()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    DELETE FROM votes v USING deleted_comments d WHERE v.target_type = 'comment' AND v.target_id = d.id;
+    RETURN NULL;
+END
+$$;
+
+CREATE TRIGGER trg_votes_delete_posts
+    AFTER DELETE ON posts
+    REFERENCING OLD TABLE AS deleted_posts
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION votes_delete_posts();
+
+CREATE TRIGGER trg_votes_delete_comments
+    AFTER DELETE ON comments
+    REFERENCING OLD TABLE AS deleted_comments
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION votes_delete_comments();
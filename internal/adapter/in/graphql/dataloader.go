@@ -0,0 +1,197 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// loaderWait — сколько лоадер ждет остальные id перед походом в хранилище
+	loaderWait = time.Millisecond
+	// loaderMaxBatch ограничивает размер одного запроса к хранилищу
+	loaderMaxBatch = 250
+)
+
+// loader собирает id, запрошенные резолверами одной операции, и загружает их одним батчем.
+type loader[V any] struct {
+	// ctx операции: батч переживает контекст отдельного поля
+	ctx   context.Context
+	fetch func(ctx context.Context, ids []int64) ([]V, error)
+	key   func(V) int64
+
+	mu      sync.Mutex
+	cache   map[int64]*loadResult[V]
+	pending *loadBatch
+}
+
+type loadResult[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+}
+
+type loadBatch struct {
+	ids  []int64
+	once sync.Once
+}
+
+func newLoader[V any](ctx context.Context, fetch func(context.Context, []int64) ([]V, error), key func(V) int64) *loader[V] {
+	return &loader[V]{
+		ctx:   ctx,
+		fetch: fetch,
+		key:   key,
+		cache: make(map[int64]*loadResult[V]),
+	}
+}
+
+// Load возвращает значение по id; отсутствующее в хранилище — service.ErrNotFound.
+func (l *loader[V]) Load(ctx context.Context, id int64) (V, error) {
+	l.mu.Lock()
+	res, ok := l.cache[id]
+	if !ok {
+		res = &loadResult[V]{done: make(chan struct{})}
+		l.cache[id] = res
+
+		if l.pending == nil {
+			b := &loadBatch{}
+			l.pending = b
+			time.AfterFunc(loaderWait, func() { l.dispatch(b) })
+		}
+		b := l.pending
+		b.ids = append(b.ids, id)
+		if len(b.ids) >= loaderMaxBatch {
+			l.pending = nil
+			go l.dispatch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.val, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *loader[V]) dispatch(b *loadBatch) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.pending == b {
+			l.pending = nil
+		}
+		ids := b.ids
+		l.mu.Unlock()
+
+		items, err := l.fetch(l.ctx, ids)
+		found := make(map[int64]V, len(items))
+		for _, it := range items {
+			found[l.key(it)] = it
+		}
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, id := range ids {
+			res := l.cache[id]
+			switch v, ok := found[id]; {
+			case err != nil:
+				res.err = err
+				delete(l.cache, id)
+			case ok:
+				res.val = v
+			default:
+				res.err = service.ErrNotFound
+			}
+			close(res.done)
+		}
+	})
+}
+
+// loaders — лоадеры одной операции
+type loaders struct {
+	posts    *loader[model.Post]
+	comments *loader[model.Comment]
+	// голоса текущего пользователя по типу цели, ключ — id цели
+	votes map[model.VoteTarget]*loader[model.Vote]
+}
+
+type loadersKey struct{}
+
+// Dataloaders подключается через AroundOperations и заводит лоадеры на каждую операцию.
+func (r *Resolver) Dataloaders(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation != nil && oc.Operation.Operation == ast.Subscription {
+		responses := next(ctx)
+		return func(ctx context.Context) *graphql.Response {
+			return responses(r.withLoaders(ctx))
+		}
+	}
+	return next(r.withLoaders(ctx))
+}
+
+func (r *Resolver) withLoaders(ctx context.Context) context.Context {
+	voteKey := func(v model.Vote) int64 { return v.TargetID }
+	votesOf := func(target model.VoteTarget) func(context.Context, []int64) ([]model.Vote, error) {
+		return func(ctx context.Context, ids []int64) ([]model.Vote, error) {
+			return r.voteService.ViewerVotes(ctx, target, ids)
+		}
+	}
+
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		posts: newLoader(ctx, r.postsService.GetPostsByIDs, func(p model.Post) int64 { return p.ID }),
+		comments: newLoader(ctx, r.commentService.GetCommentsByIDs, func(c model.Comment) int64 {
+			return c.ID
+		}),
+		votes: map[model.VoteTarget]*loader[model.Vote]{
+			model.VoteTargetPost:    newLoader(ctx, votesOf(model.VoteTargetPost), voteKey),
+			model.VoteTargetComment: newLoader(ctx, votesOf(model.VoteTargetComment), voteKey),
+		},
+	})
+}
+
+// loadPost читает пост через лоадер операции, вне операции — напрямую из сервиса.
+func (r *Resolver) loadPost(ctx context.Context, id int64) (model.Post, error) {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l.posts.Load(ctx, id)
+	}
+	return r.postsService.GetPostByID(ctx, id)
+}
+
+func (r *Resolver) loadComment(ctx context.Context, id int64) (model.Comment, error) {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l.comments.Load(ctx, id)
+	}
+	return r.commentService.GetCommentByID(ctx, id)
+}
+
+// loadViewerVote читает голос текущего пользователя через лоадер операции.
+func (r *Resolver) loadViewerVote(ctx context.Context, target model.VoteTarget, id int64) (*int8, error) {
+	l, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok || l.votes[target] == nil {
+		return r.voteService.ViewerVote(ctx, target, id)
+	}
+	if _, authed := auth.UserIDFromContext(ctx); !authed {
+		return nil, nil
+	}
+
+	v, err := l.votes[target].Load(ctx, id)
+	if errors.Is(err, service.ErrNotFound) {
+		// голоса нет
+		var none int8
+		return &none, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v.Value, nil
+}
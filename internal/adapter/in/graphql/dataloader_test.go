@@ -0,0 +1,165 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/mock/gomock"
+)
+
+func newPostLoader(t *testing.T, calls *atomic.Int32, batches chan<- []int64, err error) *loader[model.Post] {
+	t.Helper()
+	return newLoader(context.Background(), func(_ context.Context, ids []int64) ([]model.Post, error) {
+		calls.Add(1)
+		if batches != nil {
+			batches <- append([]int64(nil), ids...)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out := make([]model.Post, 0, len(ids))
+		for _, id := range ids {
+			if id == 404 {
+				continue
+			}
+			out = append(out, model.Post{ID: id})
+		}
+		return out, nil
+	}, func(p model.Post) int64 { return p.ID })
+}
+
+func TestLoader_BatchesConcurrentLoads(t *testing.T) {
+	var calls atomic.Int32
+	batches := make(chan []int64, 1)
+	l := newPostLoader(t, &calls, batches, nil)
+
+	var wg sync.WaitGroup
+	for _, id := range []int64{1, 2, 3, 2} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p, err := l.Load(context.Background(), id)
+			require.NoError(t, err)
+			require.Equal(t, id, p.ID)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+	require.ElementsMatch(t, []int64{1, 2, 3}, <-batches)
+
+	// повторная загрузка берется из кеша
+	_, err := l.Load(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestLoader_MissingID(t *testing.T) {
+	var calls atomic.Int32
+	l := newPostLoader(t, &calls, nil, nil)
+
+	_, err := l.Load(context.Background(), 404)
+	require.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestLoader_FetchError(t *testing.T) {
+	var calls atomic.Int32
+	boom := errors.New("boom")
+	l := newPostLoader(t, &calls, nil, boom)
+
+	_, err := l.Load(context.Background(), 1)
+	require.ErrorIs(t, err, boom)
+
+	// ошибка не кешируется: повторный Load снова идет в хранилище
+	_, err = l.Load(context.Background(), 1)
+	require.ErrorIs(t, err, boom)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestLoader_ContextCanceled(t *testing.T) {
+	var calls atomic.Int32
+	l := newPostLoader(t, &calls, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := l.Load(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDataloaders_FreshLoadersPerSubscriptionEvent(t *testing.T) {
+	// сервисы не вызываются: лоадеры только создаются
+	r := NewResolver(nil, nil, nil, ResolverConfig{})
+	loadersOf := func(ctx context.Context) *loaders {
+		l, _ := ctx.Value(loadersKey{}).(*loaders)
+		return l
+	}
+
+	var seen []*loaders
+	handler := func(ctx context.Context) graphql.ResponseHandler {
+		require.Nil(t, loadersOf(ctx), "у операции подписки общего лоадера нет")
+		return func(ctx context.Context) *graphql.Response {
+			seen = append(seen, loadersOf(ctx))
+			return &graphql.Response{}
+		}
+	}
+
+	sub := withOperation(context.Background(), ast.Subscription)
+	h := r.Dataloaders(sub, handler)
+	h(sub)
+	h(sub)
+	require.Len(t, seen, 2)
+	require.NotNil(t, seen[0])
+	require.NotSame(t, seen[0], seen[1])
+
+	// у запроса лоадеры общие на всю операцию
+	query := withOperation(context.Background(), ast.Query)
+	r.Dataloaders(query, func(ctx context.Context) graphql.ResponseHandler {
+		require.NotNil(t, loadersOf(ctx))
+		return graphql.OneShot(&graphql.Response{})
+	})
+}
+
+func TestLoadViewerVote_Batches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	votes := service.NewMockVoteStorage(ctrl)
+	r := NewResolver(nil, nil, service.NewVoteService(votes, nil, nil, nil), ResolverConfig{})
+
+	// голоса всех комментариев ответа читаются одним запросом
+	votes.EXPECT().
+		GetVotes(gomock.Any(), int64(7), model.VoteTargetComment, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, _ model.VoteTarget, ids []int64) ([]model.Vote, error) {
+			require.ElementsMatch(t, []int64{1, 2}, ids)
+			return []model.Vote{{UserID: 7, TargetType: model.VoteTargetComment, TargetID: 1, Value: -1}}, nil
+		})
+
+	ctx := r.withLoaders(auth.WithUserID(context.Background(), 7))
+	got := make([]*int8, 2)
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := r.loadViewerVote(ctx, model.VoteTargetComment, int64(i+1))
+			require.NoError(t, err)
+			got[i] = v
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int8(-1), *got[0])
+	require.Equal(t, int8(0), *got[1], "нет голоса — 0")
+
+	// у анонима голоса нет, хранилище не вызывается
+	v, err := r.loadViewerVote(r.withLoaders(context.Background()), model.VoteTargetComment, 1)
+	require.NoError(t, err)
+	require.Nil(t, v)
+}
@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"myreddit/internal/service"
+	"myreddit/pkg/logger"
+	"myreddit/pkg/requestid"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Значения extensions.code в ответе
+const (
+	CodeBadUserInput    = "BAD_USER_INPUT"
+	CodeNotFound        = "NOT_FOUND"
+	CodeForbidden       = "FORBIDDEN"
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeInternal        = "INTERNAL"
+
+	CodeTooManySubscriptions = "TOO_MANY_SUBSCRIPTIONS"
+	CodeSubscriptionLagged   = "SUBSCRIPTION_LAGGED"
+	CodeUnavailable          = "UNAVAILABLE"
+)
+
+const internalErrorMessage = "internal error"
+
+// ErrorPresenter проставляет ошибкам резолверов extensions.code по ошибкам сервиса.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	// ошибки разбора и проверки запроса gqlgen формирует сам
+	if gqlErr.Err == nil {
+		return gqlErr
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]any)
+	}
+	code := errorCode(gqlErr.Err)
+	gqlErr.Extensions["code"] = code
+	if id := requestid.FromContext(ctx); id != "" {
+		gqlErr.Extensions["requestId"] = id
+	}
+
+	if code == CodeInternal {
+		logger.FromContext(ctx).Error("graphql internal error", "path", gqlErr.Path.String(), "error", gqlErr.Err)
+		gqlErr.Message = internalErrorMessage
+		return gqlErr
+	}
+
+	var verrs validator.ValidationErrors
+	if errors.As(gqlErr.Err, &verrs) {
+		gqlErr.Extensions["fields"] = fieldErrors(verrs)
+	}
+	return gqlErr
+}
+
+func errorCode(err error) string {
+	var numErr *strconv.NumError
+	switch {
+	case errors.Is(err, service.ErrInvalidRequest), errors.As(err, &numErr):
+		return CodeBadUserInput
+	case errors.Is(err, service.ErrNotFound):
+		return CodeNotFound
+	case errors.Is(err, service.ErrForbidden):
+		return CodeForbidden
+	case errors.Is(err, service.ErrUnauthenticated):
+		return CodeUnauthenticated
+	case errors.Is(err, errTooManySubscriptions):
+		return CodeTooManySubscriptions
+	case errors.Is(err, service.ErrSubscriptionLagged):
+		return CodeSubscriptionLagged
+	case errors.Is(err, errShuttingDown):
+		return CodeUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
+// fieldErrors раскладывает ошибки валидатора по полям запроса.
+func fieldErrors(verrs validator.ValidationErrors) []map[string]any {
+	out := make([]map[string]any, 0, len(verrs))
+	for _, fe := range verrs {
+		f := map[string]any{
+			"field": fe.Field(),
+			"rule":  fe.Tag(),
+		}
+		if p := fe.Param(); p != "" {
+			f["param"] = p
+		}
+		out = append(out, f)
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"myreddit/internal/service"
+	"myreddit/pkg/requestid"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestErrorPresenter(t *testing.T) {
+	ctx := requestid.WithID(context.Background(), "req-1")
+
+	verr := validator.New().Struct(service.CreatePostRequest{Title: "t"})
+	_, numErr := strconv.ParseInt("abc", 10, 64)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+		wantMsg  string
+	}{
+		{name: "not found", err: fmt.Errorf("post: %w", service.ErrNotFound), wantCode: CodeNotFound, wantMsg: "post: not found"},
+		{name: "forbidden", err: service.ErrForbidden, wantCode: CodeForbidden, wantMsg: "action forbidden"},
+		{name: "unauthenticated", err: service.ErrUnauthenticated, wantCode: CodeUnauthenticated, wantMsg: "unauthenticated"},
+		{name: "parent mismatch", err: service.ErrParentPostMismatch, wantCode: CodeBadUserInput, wantMsg: service.ErrParentPostMismatch.Error()},
+		{name: "bad id", err: numErr, wantCode: CodeBadUserInput, wantMsg: numErr.Error()},
+		{name: "internal", err: errors.New("exec select comments: connection refused"), wantCode: CodeInternal, wantMsg: internalErrorMessage},
+		{name: "internal sentinel", err: service.ErrInternalError, wantCode: CodeInternal, wantMsg: internalErrorMessage},
+		{name: "validation", err: fmt.Errorf("%w: %w", service.ErrInvalidRequest, verr), wantCode: CodeBadUserInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorPresenter(ctx, tt.err)
+			require.Equal(t, tt.wantCode, got.Extensions["code"])
+			require.Equal(t, "req-1", got.Extensions["requestId"])
+			if tt.wantMsg != "" {
+				require.Equal(t, tt.wantMsg, got.Message)
+			}
+		})
+	}
+
+	got := ErrorPresenter(ctx, fmt.Errorf("%w: %w", service.ErrInvalidRequest, verr))
+	require.Equal(t, []map[string]any{{"field": "Text", "rule": "required"}}, got.Extensions["fields"])
+}
+
+func TestErrorPresenter_KeepsQueryErrors(t *testing.T) {
+	in := gqlerror.Errorf("cannot query field")
+	in.Extensions = map[string]any{"code": "GRAPHQL_VALIDATION_FAILED"}
+
+	got := ErrorPresenter(context.Background(), in)
+	require.Equal(t, "cannot query field", got.Message)
+	require.Equal(t, "GRAPHQL_VALIDATION_FAILED", got.Extensions["code"])
+}
@@ -0,0 +1,79 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	gqlmodel "myreddit/internal/adapter/in/graphql/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/globalid"
+)
+
+// Типы объектов в глобальных ID
+const (
+	nodeTypePost    = "Post"
+	nodeTypeComment = "Comment"
+)
+
+// maxNodes ограничивает число ID в одном запросе nodes
+const maxNodes = 100
+
+func postGlobalID(id int64) string    { return globalid.Encode(nodeTypePost, id) }
+func commentGlobalID(id int64) string { return globalid.Encode(nodeTypeComment, id) }
+
+// postID разбирает ID поста из аргумента запроса.
+func (r *Resolver) postID(id string) (int64, error) {
+	return r.decodeID(id, nodeTypePost)
+}
+
+// commentID разбирает ID комментария из аргумента запроса.
+func (r *Resolver) commentID(id string) (int64, error) {
+	return r.decodeID(id, nodeTypeComment)
+}
+
+// decodeID принимает глобальный ID типа typ, а при включённом LegacyNumericIDs — и старый числовой.
+func (r *Resolver) decodeID(id, typ string) (int64, error) {
+	gotTyp, n, err := globalid.Decode(id)
+	if err == nil {
+		if gotTyp != typ {
+			return 0, fmt.Errorf("id %q is not a %s id: %w", id, typ, service.ErrInvalidRequest)
+		}
+		return n, nil
+	}
+
+	if r.cfg.LegacyNumericIDs {
+		if n, err := strconv.ParseInt(id, 10, 64); err == nil && n > 0 {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s id %q: %w", typ, id, service.ErrInvalidRequest)
+}
+
+// loadNode загружает объект по глобальному ID; ненайденный — nil без ошибки.
+func (r *Resolver) loadNode(ctx context.Context, id string) (gqlmodel.Node, error) {
+	typ, n, err := globalid.Decode(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node id %q: %w", id, service.ErrInvalidRequest)
+	}
+
+	var node gqlmodel.Node
+	switch typ {
+	case nodeTypePost:
+		p, lerr := r.loadPost(ctx, n)
+		node, err = toPostNode(p), lerr
+	case nodeTypeComment:
+		c, lerr := r.loadComment(ctx, n)
+		node, err = toCommentNode(c), lerr
+	default:
+		return nil, fmt.Errorf("unknown node type %q: %w", typ, service.ErrInvalidRequest)
+	}
+	if errors.Is(err, service.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
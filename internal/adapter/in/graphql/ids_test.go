@@ -0,0 +1,81 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	gqlmodel "myreddit/internal/adapter/in/graphql/model"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/globalid"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stubPosts отдает посты из map, остальные методы сервиса не нужны
+type stubPosts struct {
+	PostService
+	posts map[int64]model.Post
+}
+
+func (s stubPosts) GetPostByID(_ context.Context, id int64) (model.Post, error) {
+	p, ok := s.posts[id]
+	if !ok {
+		return model.Post{}, service.ErrNotFound
+	}
+	return p, nil
+}
+
+func TestResolver_DecodeID(t *testing.T) {
+	tests := []struct {
+		name    string
+		legacy  bool
+		id      string
+		want    int64
+		wantErr error
+	}{
+		{name: "global", id: postGlobalID(42), want: 42},
+		{name: "other type", id: commentGlobalID(42), wantErr: service.ErrInvalidRequest},
+		{name: "numeric without legacy", id: "42", wantErr: service.ErrInvalidRequest},
+		{name: "numeric with legacy", legacy: true, id: "42", want: 42},
+		{name: "global with legacy", legacy: true, id: postGlobalID(7), want: 7},
+		{name: "garbage with legacy", legacy: true, id: "abc", wantErr: service.ErrInvalidRequest},
+		{name: "non-positive with legacy", legacy: true, id: "0", wantErr: service.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Resolver{cfg: ResolverConfig{LegacyNumericIDs: tt.legacy}}
+			got, err := r.postID(tt.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_LoadNode(t *testing.T) {
+	r := &Resolver{postsService: stubPosts{posts: map[int64]model.Post{1: {ID: 1, Title: "t"}}}}
+	ctx := context.Background()
+
+	node, err := r.loadNode(ctx, postGlobalID(1))
+	require.NoError(t, err)
+	post, ok := node.(*gqlmodel.Post)
+	require.True(t, ok)
+	require.Equal(t, postGlobalID(1), post.ID)
+	require.Equal(t, "t", post.Title)
+
+	// ненайденный объект — null без ошибки
+	node, err = r.loadNode(ctx, postGlobalID(2))
+	require.NoError(t, err)
+	require.Nil(t, node)
+
+	_, err = r.loadNode(ctx, globalid.Encode("User", 1))
+	require.ErrorIs(t, err, service.ErrInvalidRequest)
+
+	// числовой ID не указывает тип и в node не принимается
+	_, err = r.loadNode(ctx, "1")
+	require.ErrorIs(t, err, service.ErrInvalidRequest)
+}
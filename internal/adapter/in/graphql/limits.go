@@ -0,0 +1,186 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"myreddit/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	errTooManySubscriptions = errors.New("too many active subscriptions")
+	errShuttingDown         = errors.New("server is shutting down")
+)
+
+type SubscriptionLimits struct {
+	// PerConnection — подписок на одном websocket-соединении, 0 — без ограничения
+	PerConnection int
+	// PerUser — подписок одного пользователя по всем соединениям, 0 — без ограничения.
+	PerUser int
+}
+
+// SubscriptionLimiter ограничивает число активных подписок.
+type SubscriptionLimiter struct {
+	limits SubscriptionLimits
+
+	mu      sync.Mutex
+	users   map[int64]int
+	subs    map[*liveSubscription]struct{}
+	conns   map[*connSlots]struct{}
+	closing bool
+}
+
+type connSlotsKey struct{}
+
+// connSlots — активные подписки соединения, защищены мьютексом лимитера
+type connSlots struct {
+	n     int
+	close context.CancelFunc
+}
+
+// liveSubscription — выполняющаяся подписка
+type liveSubscription struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewSubscriptionLimiter(limits SubscriptionLimits) *SubscriptionLimiter {
+	return &SubscriptionLimiter{
+		limits: limits,
+		users:  make(map[int64]int),
+		subs:   make(map[*liveSubscription]struct{}),
+		conns:  make(map[*connSlots]struct{}),
+	}
+}
+
+// WithConnection заводит счетчик подписок соединения
+func (l *SubscriptionLimiter) WithConnection(ctx context.Context) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	conn := &connSlots{close: cancel}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closing {
+		cancel()
+		return ctx
+	}
+	l.conns[conn] = struct{}{}
+	context.AfterFunc(ctx, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.conns, conn)
+	})
+	return context.WithValue(ctx, connSlotsKey{}, conn)
+}
+
+// AroundOperations отклоняет подписку сверх лимита ошибкой TOO_MANY_SUBSCRIPTIONS.
+func (l *SubscriptionLimiter) AroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &liveSubscription{cancel: cancel, done: make(chan struct{})}
+	release, err := l.acquire(ctx, sub)
+	if err != nil {
+		cancel()
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{ErrorPresenter(ctx, err)}})
+	}
+	// транспорт отменяет контекст операции при complete и разрыве соединения
+	context.AfterFunc(ctx, release)
+
+	responses := next(ctx)
+	finish := sync.OnceFunc(func() {
+		release()
+		cancel()
+		close(sub.done)
+	})
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			finish()
+		}
+		return resp
+	}
+}
+
+// Shutdown завершает активные подписки и закрывает websocket-соединения.
+func (l *SubscriptionLimiter) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.closing = true
+	subs := make([]*liveSubscription, 0, len(l.subs))
+	for sub := range l.subs {
+		subs = append(subs, sub)
+	}
+	l.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.cancel()
+	}
+	var err error
+wait:
+	for _, sub := range subs {
+		select {
+		case <-sub.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break wait
+		}
+	}
+
+	l.mu.Lock()
+	for conn := range l.conns {
+		conn.close()
+	}
+	l.mu.Unlock()
+	return err
+}
+
+func (l *SubscriptionLimiter) acquire(ctx context.Context, sub *liveSubscription) (func(), error) {
+	conn, _ := ctx.Value(connSlotsKey{}).(*connSlots)
+	userID, authed := auth.UserIDFromContext(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closing {
+		return nil, errShuttingDown
+	}
+	if conn != nil && l.limits.PerConnection > 0 && conn.n >= l.limits.PerConnection {
+		return nil, fmt.Errorf("%w: connection limit is %d", errTooManySubscriptions, l.limits.PerConnection)
+	}
+	if authed && l.limits.PerUser > 0 && l.users[userID] >= l.limits.PerUser {
+		return nil, fmt.Errorf("%w: user limit is %d", errTooManySubscriptions, l.limits.PerUser)
+	}
+
+	if conn != nil {
+		conn.n++
+	}
+	if authed {
+		l.users[userID]++
+	}
+	l.subs[sub] = struct{}{}
+
+	return sync.OnceFunc(func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		delete(l.subs, sub)
+		if conn != nil {
+			conn.n--
+		}
+		if authed {
+			if l.users[userID]--; l.users[userID] == 0 {
+				delete(l.users, userID)
+			}
+		}
+	}), nil
+}
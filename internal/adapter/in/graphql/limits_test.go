@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myreddit/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func withOperation(ctx context.Context, op ast.Operation) context.Context {
+	return graphql.WithOperationContext(ctx, &graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: op},
+	})
+}
+
+// subscribeStub — подписка, которая отдает события до отмены контекста
+func subscribeStub(ctx context.Context) graphql.ResponseHandler {
+	return func(ctx context.Context) *graphql.Response {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return &graphql.Response{}
+	}
+}
+
+func requireRejected(t *testing.T, ctx context.Context, h graphql.ResponseHandler) {
+	t.Helper()
+	resp := h(ctx)
+	require.NotNil(t, resp)
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, CodeTooManySubscriptions, resp.Errors[0].Extensions["code"])
+	require.Nil(t, h(ctx))
+}
+
+func TestSubscriptionLimiter_PerConnection(t *testing.T) {
+	l := NewSubscriptionLimiter(SubscriptionLimits{PerConnection: 2})
+	conn := withOperation(l.WithConnection(context.Background()), ast.Subscription)
+
+	first, cancelFirst := context.WithCancel(conn)
+	require.NotNil(t, l.AroundOperations(first, subscribeStub)(first))
+	second, cancelSecond := context.WithCancel(conn)
+	defer cancelSecond()
+	require.NotNil(t, l.AroundOperations(second, subscribeStub)(second))
+
+	requireRejected(t, conn, l.AroundOperations(conn, subscribeStub))
+
+	// запросы не считаются
+	query := withOperation(conn, ast.Query)
+	require.NotNil(t, l.AroundOperations(query, subscribeStub)(query))
+
+	// у другого соединения свой счетчик
+	other := withOperation(l.WithConnection(context.Background()), ast.Subscription)
+	require.NotNil(t, l.AroundOperations(other, subscribeStub)(other))
+
+	// отписка освобождает место
+	cancelFirst()
+	require.Eventually(t, func() bool {
+		third, cancel := context.WithCancel(conn)
+		defer cancel()
+		return l.AroundOperations(third, subscribeStub)(third).Errors == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSubscriptionLimiter_PerUser(t *testing.T) {
+	l := NewSubscriptionLimiter(SubscriptionLimits{PerConnection: 10, PerUser: 1})
+	user := auth.WithUserID(context.Background(), 7)
+
+	first := withOperation(l.WithConnection(user), ast.Subscription)
+	h := l.AroundOperations(first, func(context.Context) graphql.ResponseHandler {
+		return graphql.OneShot(&graphql.Response{})
+	})
+	require.NotNil(t, h(first))
+
+	// лимит пользователя общий для соединений и SSE
+	requireRejected(t, first, l.AroundOperations(withOperation(l.WithConnection(user), ast.Subscription), subscribeStub))
+	sse := withOperation(user, ast.Subscription)
+	requireRejected(t, sse, l.AroundOperations(sse, subscribeStub))
+
+	// другой пользователь и аноним не затронуты
+	other := withOperation(auth.WithUserID(context.Background(), 8), ast.Subscription)
+	require.NotNil(t, l.AroundOperations(other, subscribeStub)(other))
+	anon := withOperation(context.Background(), ast.Subscription)
+	require.NotNil(t, l.AroundOperations(anon, subscribeStub)(anon))
+
+	// завершившаяся подписка освобождает место
+	require.Nil(t, h(first))
+	require.Nil(t, l.AroundOperations(sse, subscribeStub)(sse).Errors)
+}
+
+func TestSubscriptionLimiter_Shutdown(t *testing.T) {
+	l := NewSubscriptionLimiter(SubscriptionLimits{})
+	conn := l.WithConnection(context.Background())
+	sub := withOperation(conn, ast.Subscription)
+
+	// подписка отдает события, пока транспорт не отменит ее контекст
+	var opCtx context.Context
+	h := l.AroundOperations(sub, func(ctx context.Context) graphql.ResponseHandler {
+		opCtx = ctx
+		return subscribeStub(ctx)
+	})
+	require.NotNil(t, h(opCtx))
+
+	ended := make(chan struct{})
+	go func() {
+		defer close(ended)
+		for h(opCtx) != nil {
+		}
+	}()
+
+	require.NoError(t, l.Shutdown(context.Background()))
+	<-ended
+	require.Error(t, conn.Err(), "websocket-соединение закрыто")
+
+	// новые подписки и соединения после остановки не принимаются
+	resp := l.AroundOperations(sub, subscribeStub)(sub)
+	require.Equal(t, CodeUnavailable, resp.Errors[0].Extensions["code"])
+	require.Error(t, l.WithConnection(context.Background()).Err())
+}
+
+func TestSubscriptionLimiter_ShutdownTimeout(t *testing.T) {
+	l := NewSubscriptionLimiter(SubscriptionLimits{})
+	sub := withOperation(context.Background(), ast.Subscription)
+	// транспорт не дочитал подписку до конца
+	l.AroundOperations(sub, subscribeStub)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, l.Shutdown(ctx), context.DeadlineExceeded)
+}
@@ -1,63 +1,223 @@
 package graphql
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"strconv"
-	"time"
 
 	gqlmodel "myreddit/internal/adapter/in/graphql/model"
 	"myreddit/internal/model"
+	"myreddit/internal/service"
 	"myreddit/pkg/pagination"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
 func toPostNode(p model.Post) *gqlmodel.Post {
 	return &gqlmodel.Post{
-		ID:              strconv.FormatInt(p.ID, 10),
+		ID:              postGlobalID(p.ID),
 		Title:           p.Title,
 		Body:            p.Text,
 		UserID:          strconv.FormatInt(p.UserID, 10),
 		CommentsEnabled: p.CommentsEnabled,
 		CreatedAt:       p.CreatedAt,
+		EditedAt:        p.EditedAt,
+		Score:           int(p.Score()),
+		Upvotes:         int(p.Upvotes),
+		Downvotes:       int(p.Downvotes),
 	}
 }
 
+// toCommentNode скрывает у удаленного комментария текст и автора.
 func toCommentNode(c model.Comment) *gqlmodel.Comment {
-	var parent *string
-	if c.ParentID != nil {
-		s := strconv.FormatInt(*c.ParentID, 10)
-		parent = &s
+	body := c.Body
+	var userID *string
+	if c.IsDeleted() {
+		body = model.DeletedCommentBody
+	} else {
+		uid := strconv.FormatInt(c.UserID, 10)
+		userID = &uid
 	}
 	return &gqlmodel.Comment{
-		ID:        strconv.FormatInt(c.ID, 10),
-		PostID:    strconv.FormatInt(c.PostID, 10),
-		ParentID:  parent,
-		UserID:    strconv.FormatInt(c.UserID, 10),
-		Body:      c.Body,
-		CreatedAt: c.CreatedAt,
+		ID:         commentGlobalID(c.ID),
+		PostID:     postGlobalID(c.PostID),
+		ParentID:   toCommentGlobalID(c.ParentID),
+		UserID:     userID,
+		Body:       body,
+		IsDeleted:  c.IsDeleted(),
+		CreatedAt:  c.CreatedAt,
+		EditedAt:   c.EditedAt,
+		Score:      int(c.Score()),
+		Upvotes:    int(c.Upvotes),
+		Downvotes:  int(c.Downvotes),
+		ReplyCount: int(c.ReplyCount),
+		Depth:      c.Depth,
 	}
 }
 
-func encodeCursor(id int64, ts time.Time) *string {
-	cursor := pagination.Cursor{CreatedAt: ts, ID: id}
-	return cursor.Encode()
+func toPostUpdate(ch service.PostChange) gqlmodel.PostUpdate {
+	if ch.Kind == service.EventDeleted {
+		return &gqlmodel.PostDeleted{PostID: postGlobalID(ch.PostID)}
+	}
+	return &gqlmodel.PostEdited{Post: toPostNode(ch.Post)}
 }
 
-func toPageRequest(in *gqlmodel.PageInput) pagination.PageRequest {
-	var limit int
-	var before, after *string
-	if in != nil {
-		if in.Limit != nil {
-			limit = *in.Limit
-		}
-		if in.Before != nil && *in.Before != "" {
-			before = in.Before
-		}
-		if in.After != nil && *in.After != "" {
-			after = in.After
+func toCommentChange(ch service.CommentChange) gqlmodel.CommentChange {
+	node := toCommentNode(ch.Comment)
+	switch ch.Kind {
+	case service.EventEdited:
+		return &gqlmodel.CommentEdited{Comment: node}
+	case service.EventDeleted:
+		return &gqlmodel.CommentDeleted{Comment: node}
+	default:
+		return &gqlmodel.CommentCreated{Comment: node}
+	}
+}
+
+func toCommentTreeNodes(nodes []*model.CommentNode) []*gqlmodel.CommentTreeNode {
+	out := make([]*gqlmodel.CommentTreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, &gqlmodel.CommentTreeNode{
+			Comment: toCommentNode(n.Comment),
+			Replies: toCommentTreeNodes(n.Replies),
+			More:    toMoreComments(n.More),
+		})
+	}
+	return out
+}
+
+func toMoreComments(m *model.MoreComments) *gqlmodel.MoreComments {
+	if m == nil {
+		return nil
+	}
+	return &gqlmodel.MoreComments{
+		ParentID: toCommentGlobalID(m.ParentID),
+		Cursor:   m.Cursor,
+	}
+}
+
+// toPageRequest сводит limit/first/last к лимиту и направлению
+func toPageRequest(ctx context.Context, in *gqlmodel.PageInput) (pagination.PageRequest, error) {
+	req := pagination.PageRequest{WithTotal: selectsField(ctx, "totalCount")}
+	if in == nil {
+		return req, nil
+	}
+
+	if in.Before != nil && *in.Before != "" {
+		req.BeforeCursor = in.Before
+	}
+	if in.After != nil && *in.After != "" {
+		req.AfterCursor = in.After
+	}
+
+	set := 0
+	for _, v := range []*int{in.Limit, in.First, in.Last} {
+		if v != nil {
+			set++
+			req.Limit = *v
 		}
 	}
-	return pagination.PageRequest{
-		Limit:        limit,
-		BeforeCursor: before,
-		AfterCursor:  after,
+	if set > 1 {
+		return req, fmt.Errorf("only one of limit, first, last may be set: %w", service.ErrInvalidRequest)
+	}
+	if in.First != nil && req.BeforeCursor != nil {
+		return req, fmt.Errorf("first with before: %w", service.ErrInvalidRequest)
+	}
+	if in.Last != nil && req.BeforeCursor == nil {
+		req.Last = true
+	}
+	return req, nil
+}
+
+// selectsField проверяет, выбрано ли поле name у текущего поля запроса.
+func selectsField(ctx context.Context, name string) bool {
+	if graphql.GetFieldContext(ctx) == nil {
+		return false
+	}
+	return slices.Contains(graphql.CollectAllFields(ctx), name)
+}
+
+func toPageInfo[T any](pg pagination.Page[T]) *gqlmodel.PageInfo {
+	return &gqlmodel.PageInfo{
+		StartCursor:     pg.StartCursor,
+		EndCursor:       pg.EndCursor,
+		HasNextPage:     pg.HasNextPage,
+		HasPreviousPage: pg.HasPreviousPage,
+		Count:           pg.Count,
+	}
+}
+
+func toPostSort(in *gqlmodel.PostSort) model.PostSort {
+	if in == nil {
+		return model.PostSortNew
+	}
+	switch *in {
+	case gqlmodel.PostSortHot:
+		return model.PostSortHot
+	case gqlmodel.PostSortTop:
+		return model.PostSortTop
+	case gqlmodel.PostSortControversial:
+		return model.PostSortControversial
+	default:
+		return model.PostSortNew
+	}
+}
+
+func toCommentSort(in *gqlmodel.CommentSort) model.CommentSort {
+	if in == nil {
+		return model.CommentSortNew
+	}
+	switch *in {
+	case gqlmodel.CommentSortOld:
+		return model.CommentSortOld
+	case gqlmodel.CommentSortTop:
+		return model.CommentSortTop
+	case gqlmodel.CommentSortBest:
+		return model.CommentSortBest
+	case gqlmodel.CommentSortControversial:
+		return model.CommentSortControversial
+	default:
+		return model.CommentSortNew
+	}
+}
+
+func toTimeWindow(in *gqlmodel.TimeWindow) model.TimeWindow {
+	if in == nil {
+		return model.TimeWindowAll
+	}
+	switch *in {
+	case gqlmodel.TimeWindowDay:
+		return model.TimeWindowDay
+	case gqlmodel.TimeWindowWeek:
+		return model.TimeWindowWeek
+	case gqlmodel.TimeWindowMonth:
+		return model.TimeWindowMonth
+	default:
+		return model.TimeWindowAll
+	}
+}
+
+func toCommentGlobalID(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := commentGlobalID(*id)
+	return &s
+}
+
+func toVoteValue(v *int8) *int {
+	if v == nil {
+		return nil
+	}
+	out := int(*v)
+	return &out
+}
+
+// toVoteRequestValue отсекает значения, не помещающиеся в int8, до валидации в сервисе.
+func toVoteRequestValue(v int) (int8, error) {
+	if v < -1 || v > 1 {
+		return 0, fmt.Errorf("vote value must be -1, 0 or 1: %w", service.ErrInvalidRequest)
 	}
+	return int8(v), nil
 }
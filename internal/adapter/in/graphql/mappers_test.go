@@ -0,0 +1,76 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gqlmodel "myreddit/internal/adapter/in/graphql/model"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/pagination"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToPageRequest(t *testing.T) {
+	n := func(v int) *int { return &v }
+	cur := "c"
+
+	tests := []struct {
+		name    string
+		in      *gqlmodel.PageInput
+		want    pagination.PageRequest
+		wantErr bool
+	}{
+		{name: "nil", in: nil, want: pagination.PageRequest{}},
+		{name: "limit", in: &gqlmodel.PageInput{Limit: n(5), After: &cur}, want: pagination.PageRequest{Limit: 5, AfterCursor: &cur}},
+		{name: "first", in: &gqlmodel.PageInput{First: n(5)}, want: pagination.PageRequest{Limit: 5}},
+		{name: "last", in: &gqlmodel.PageInput{Last: n(5)}, want: pagination.PageRequest{Limit: 5, Last: true}},
+		{name: "last before", in: &gqlmodel.PageInput{Last: n(5), Before: &cur}, want: pagination.PageRequest{Limit: 5, BeforeCursor: &cur}},
+		{name: "first and last", in: &gqlmodel.PageInput{First: n(1), Last: n(1)}, wantErr: true},
+		{name: "limit and first", in: &gqlmodel.PageInput{Limit: n(1), First: n(1)}, wantErr: true},
+		{name: "first before", in: &gqlmodel.PageInput{First: n(1), Before: &cur}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toPageRequest(context.Background(), tt.in)
+			if tt.wantErr {
+				require.ErrorIs(t, err, service.ErrInvalidRequest)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToChangeEvents(t *testing.T) {
+	deleted := toPostUpdate(service.PostChange{Kind: service.EventDeleted, PostID: 10})
+	require.Equal(t, &gqlmodel.PostDeleted{PostID: postGlobalID(10)}, deleted)
+
+	edited := toPostUpdate(service.PostChange{Kind: service.EventEdited, PostID: 10, Post: model.Post{ID: 10, Title: "fixed"}})
+	require.IsType(t, &gqlmodel.PostEdited{}, edited)
+	require.Equal(t, "fixed", edited.(*gqlmodel.PostEdited).Post.Title)
+
+	for kind, want := range map[service.EventKind]gqlmodel.CommentChange{
+		service.EventCreated: &gqlmodel.CommentCreated{},
+		service.EventEdited:  &gqlmodel.CommentEdited{},
+		service.EventDeleted: &gqlmodel.CommentDeleted{},
+	} {
+		require.IsType(t, want, toCommentChange(service.CommentChange{Kind: kind, Comment: model.Comment{ID: 5}}))
+	}
+}
+
+func TestToCommentNode_Deleted(t *testing.T) {
+	live := toCommentNode(model.Comment{ID: 5, PostID: 10, UserID: 42, Body: "hi"})
+	require.Equal(t, "42", *live.UserID)
+	require.Equal(t, "hi", live.Body)
+
+	// у удаленного комментария не видно ни текста, ни автора
+	now := time.Now()
+	deleted := toCommentNode(model.Comment{ID: 5, PostID: 10, UserID: 42, DeletedAt: &now})
+	require.Nil(t, deleted.UserID)
+	require.Equal(t, model.DeletedCommentBody, deleted.Body)
+	require.True(t, deleted.IsDeleted)
+}
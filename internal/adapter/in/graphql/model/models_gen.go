@@ -3,67 +3,374 @@
 package gqlmodel
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+type CommentChange interface {
+	IsCommentChange()
+}
+
+// Объект с глобальным ID: непрозрачная строка, уникальная среди всех типов
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type PostUpdate interface {
+	IsPostUpdate()
+}
+
 type Comment struct {
-	ID        string    `json:"id"`
-	PostID    string    `json:"postId"`
-	ParentID  *string   `json:"parentId,omitempty"`
-	UserID    string    `json:"userId"`
-	Body      string    `json:"body"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID       string  `json:"id"`
+	PostID   string  `json:"postId"`
+	ParentID *string `json:"parentId,omitempty"`
+	// Автор; null у удаленного комментария
+	UserID *string `json:"userId,omitempty"`
+	// Для удаленного комментария — "[deleted]"
+	Body      string     `json:"body"`
+	IsDeleted bool       `json:"isDeleted"`
+	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+	// upvotes - downvotes
+	Score     int `json:"score"`
+	Upvotes   int `json:"upvotes"`
+	Downvotes int `json:"downvotes"`
+	// Голос текущего пользователя: 1, -1 или 0; null для анонимного запроса
+	ViewerVote *int `json:"viewerVote,omitempty"`
+	// Число прямых ответов, включая удаленные; ответы отдает replies
+	ReplyCount int `json:"replyCount"`
+	// Глубина вложенности, у корневых 0
+	Depth int   `json:"depth"`
+	Post  *Post `json:"post"`
+	// Родительский комментарий, null у корневых
+	Parent *Comment `json:"parent,omitempty"`
+}
+
+func (Comment) IsNode()            {}
+func (this Comment) GetID() string { return this.ID }
+
+type CommentAddedEvent struct {
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
+	// Сколько событий потеряно перед этим, пока клиент не успевал читать. Больше 0 — переподключиться с after курсора предыдущего события
+	Missed int `json:"missed"`
 }
 
 type CommentConnection struct {
 	Edges    []*CommentEdge `json:"edges"`
 	Nodes    []*Comment     `json:"nodes"`
 	PageInfo *PageInfo      `json:"pageInfo"`
+	// Всего элементов в выдаче; считается, только если запрошено
+	TotalCount int `json:"totalCount"`
 }
 
+type CommentCreated struct {
+	Comment *Comment `json:"comment"`
+}
+
+func (CommentCreated) IsCommentChange() {}
+
+// Комментарий удален мягко и приходит с isDeleted: true, ответы на него остаются
+type CommentDeleted struct {
+	Comment *Comment `json:"comment"`
+}
+
+func (CommentDeleted) IsCommentChange() {}
+
 type CommentEdge struct {
 	Cursor string   `json:"cursor"`
 	Node   *Comment `json:"node"`
 }
 
+type CommentEdited struct {
+	Comment *Comment `json:"comment"`
+}
+
+func (CommentEdited) IsCommentChange() {}
+
+type CommentTree struct {
+	Nodes []*CommentTreeNode `json:"nodes"`
+	// Не все корневые комментарии попали в дерево
+	More *MoreComments `json:"more,omitempty"`
+}
+
+type CommentTreeNode struct {
+	Comment *Comment           `json:"comment"`
+	Replies []*CommentTreeNode `json:"replies"`
+	// Не все ответы попали в дерево
+	More *MoreComments `json:"more,omitempty"`
+}
+
+// Заглушка «еще ответы» на месте обрезанной части дерева
+type MoreComments struct {
+	// Чьи ответы не показаны; null — корневые комментарии поста
+	ParentID *string `json:"parentId,omitempty"`
+	// Продолжение через replies(parentId)/comments(postId) с page.after; null — загружать с начала
+	Cursor *string `json:"cursor,omitempty"`
+}
+
 type Mutation struct {
 }
 
 type PageInfo struct {
 	StartCursor *string `json:"startCursor,omitempty"`
 	EndCursor   *string `json:"endCursor,omitempty"`
-	HasNextPage bool    `json:"hasNextPage"`
-	Count       int     `json:"count"`
+	// Есть элементы после endCursor
+	HasNextPage bool `json:"hasNextPage"`
+	// Есть элементы до startCursor
+	HasPreviousPage bool `json:"hasPreviousPage"`
+	Count           int  `json:"count"`
 }
 
+// first/after — вперед, last/before — назад; last без before — последние элементы. limit — то же, что first
 type PageInput struct {
 	Limit  *int    `json:"limit,omitempty"`
+	First  *int    `json:"first,omitempty"`
+	Last   *int    `json:"last,omitempty"`
 	Before *string `json:"before,omitempty"`
 	After  *string `json:"after,omitempty"`
 }
 
 type Post struct {
-	ID              string    `json:"id"`
-	Title           string    `json:"title"`
-	Body            string    `json:"body"`
-	UserID          string    `json:"userId"`
-	CommentsEnabled bool      `json:"commentsEnabled"`
-	CreatedAt       time.Time `json:"createdAt"`
+	ID              string     `json:"id"`
+	Title           string     `json:"title"`
+	Body            string     `json:"body"`
+	UserID          string     `json:"userId"`
+	CommentsEnabled bool       `json:"commentsEnabled"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	EditedAt        *time.Time `json:"editedAt,omitempty"`
+	// upvotes - downvotes
+	Score     int `json:"score"`
+	Upvotes   int `json:"upvotes"`
+	Downvotes int `json:"downvotes"`
+	// Голос текущего пользователя: 1, -1 или 0; null для анонимного запроса
+	ViewerVote *int `json:"viewerVote,omitempty"`
 }
 
+func (Post) IsNode()            {}
+func (this Post) GetID() string { return this.ID }
+
 type PostConnection struct {
 	Edges    []*PostEdge `json:"edges"`
 	Nodes    []*Post     `json:"nodes"`
 	PageInfo *PageInfo   `json:"pageInfo"`
+	// Всего элементов в выдаче; считается, только если запрошено
+	TotalCount int `json:"totalCount"`
 }
 
+type PostDeleted struct {
+	PostID string `json:"postId"`
+}
+
+func (PostDeleted) IsPostUpdate() {}
+
 type PostEdge struct {
 	Cursor string `json:"cursor"`
 	Node   *Post  `json:"node"`
 }
 
+type PostEdited struct {
+	Post *Post `json:"post"`
+}
+
+func (PostEdited) IsPostUpdate() {}
+
 type Query struct {
 }
 
 type Subscription struct {
 }
+
+// Порядок комментариев и ответов
+type CommentSort string
+
+const (
+	// Нижняя граница интервала Уилсона для доли голосов «за»
+	CommentSortBest CommentSort = "BEST"
+	// Сначала с большим счетом
+	CommentSortTop CommentSort = "TOP"
+	// Сначала новые
+	CommentSortNew CommentSort = "NEW"
+	// Сначала старые
+	CommentSortOld CommentSort = "OLD"
+	// Много голосов, поделенных примерно поровну
+	CommentSortControversial CommentSort = "CONTROVERSIAL"
+)
+
+var AllCommentSort = []CommentSort{
+	CommentSortBest,
+	CommentSortTop,
+	CommentSortNew,
+	CommentSortOld,
+	CommentSortControversial,
+}
+
+func (e CommentSort) IsValid() bool {
+	switch e {
+	case CommentSortBest, CommentSortTop, CommentSortNew, CommentSortOld, CommentSortControversial:
+		return true
+	}
+	return false
+}
+
+func (e CommentSort) String() string {
+	return string(e)
+}
+
+func (e *CommentSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentSort", str)
+	}
+	return nil
+}
+
+func (e CommentSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Порядок ленты постов
+type PostSort string
+
+const (
+	// Сначала новые
+	PostSortNew PostSort = "NEW"
+	// Счет с затуханием по времени
+	PostSortHot PostSort = "HOT"
+	// Сначала с большим счетом за окно window
+	PostSortTop PostSort = "TOP"
+	// Много голосов, поделенных примерно поровну
+	PostSortControversial PostSort = "CONTROVERSIAL"
+)
+
+var AllPostSort = []PostSort{
+	PostSortNew,
+	PostSortHot,
+	PostSortTop,
+	PostSortControversial,
+}
+
+func (e PostSort) IsValid() bool {
+	switch e {
+	case PostSortNew, PostSortHot, PostSortTop, PostSortControversial:
+		return true
+	}
+	return false
+}
+
+func (e PostSort) String() string {
+	return string(e)
+}
+
+func (e *PostSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostSort", str)
+	}
+	return nil
+}
+
+func (e PostSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PostSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PostSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TimeWindow string
+
+const (
+	TimeWindowDay   TimeWindow = "DAY"
+	TimeWindowWeek  TimeWindow = "WEEK"
+	TimeWindowMonth TimeWindow = "MONTH"
+	TimeWindowAll   TimeWindow = "ALL"
+)
+
+var AllTimeWindow = []TimeWindow{
+	TimeWindowDay,
+	TimeWindowWeek,
+	TimeWindowMonth,
+	TimeWindowAll,
+}
+
+func (e TimeWindow) IsValid() bool {
+	switch e {
+	case TimeWindowDay, TimeWindowWeek, TimeWindowMonth, TimeWindowAll:
+		return true
+	}
+	return false
+}
+
+func (e TimeWindow) String() string {
+	return string(e)
+}
+
+func (e *TimeWindow) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimeWindow(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeWindow", str)
+	}
+	return nil
+}
+
+func (e TimeWindow) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TimeWindow) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TimeWindow) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
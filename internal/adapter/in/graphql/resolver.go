@@ -2,34 +2,87 @@ package graphql
 
 import (
 	"context"
+	"fmt"
 	"myreddit/internal/model"
 	"myreddit/internal/service"
+	"myreddit/pkg/auth"
 	"myreddit/pkg/pagination"
+	"strconv"
 )
 
 type PostService interface {
 	CreatePost(ctx context.Context, req service.CreatePostRequest) (model.Post, error)
 	GetPostByID(ctx context.Context, postID int64) (model.Post, error)
-	GetPosts(ctx context.Context, in pagination.PageRequest) (pagination.Page[model.Post], error)
-	ChangePostCommentPermission(ctx context.Context, postID, userID int64, enabled bool) error
+	GetPostsByIDs(ctx context.Context, ids []int64) ([]model.Post, error)
+	GetPosts(ctx context.Context, in pagination.PageRequest, sort model.PostSort, window model.TimeWindow) (pagination.Page[model.Post], error)
+	ChangePostCommentPermission(ctx context.Context, postID int64, enabled bool) error
+	UpdatePost(ctx context.Context, req service.UpdatePostRequest) (model.Post, error)
+	DeletePost(ctx context.Context, postID int64) error
+	ListenPosts(ctx context.Context) (*service.Stream[model.Post], error)
+	ListenPost(ctx context.Context, postID int64) (*service.Stream[service.PostChange], error)
 }
 
 type CommentService interface {
 	CreateComment(ctx context.Context, req service.CreateCommentRequest) (model.Comment, error)
+	EditComment(ctx context.Context, req service.EditCommentRequest) (model.Comment, error)
+	DeleteComment(ctx context.Context, commentID int64) (model.Comment, error)
 	GetCommentByID(ctx context.Context, commentID int64) (model.Comment, error)
-	GetCommentsByPost(ctx context.Context, in pagination.PageRequest, postID int64) (pagination.Page[model.Comment], error)
-	GetReplies(ctx context.Context, in pagination.PageRequest, postID, parentID int64) (pagination.Page[model.Comment], error)
-	Listen(ctx context.Context, postID int64) (<-chan model.Comment, error)
+	GetCommentsByIDs(ctx context.Context, ids []int64) ([]model.Comment, error)
+	GetCommentsByPost(ctx context.Context, in pagination.PageRequest, postID int64, sort model.CommentSort, includeReplies bool) (pagination.Page[model.Comment], error)
+	GetCommentTree(ctx context.Context, req service.CommentTreeRequest) (model.CommentTree, error)
+	GetAncestors(ctx context.Context, commentID int64) ([]model.Comment, error)
+	GetReplies(ctx context.Context, in pagination.PageRequest, postID, parentID int64, sort model.CommentSort) (pagination.Page[model.Comment], error)
+	Listen(ctx context.Context, postID int64, after *string) (*service.Stream[service.CommentAddedEvent], error)
+	ListenChanges(ctx context.Context, postID int64) (*service.Stream[service.CommentChange], error)
+	ListenReplies(ctx context.Context, commentID int64, includeDescendants bool) (*service.Stream[model.Comment], error)
+}
+
+type VoteService interface {
+	VotePost(ctx context.Context, req service.VoteRequest) (model.Post, error)
+	VoteComment(ctx context.Context, req service.VoteRequest) (model.Comment, error)
+	ViewerVote(ctx context.Context, target model.VoteTarget, targetID int64) (*int8, error)
+	ViewerVotes(ctx context.Context, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error)
+}
+
+type ResolverConfig struct {
+	// LegacyUserIDArgs разрешает брать пользователя из устаревшего аргумента userId
+	LegacyUserIDArgs bool
+	// LegacyNumericIDs разрешает передавать в аргументах числовые ID вместо глобальных
+	LegacyNumericIDs bool
 }
 
 type Resolver struct {
 	postsService   PostService
 	commentService CommentService
+	voteService    VoteService
+	cfg            ResolverConfig
 }
 
-func NewResolver(posts *service.PostService, comments *service.CommentService) *Resolver {
+func NewResolver(posts *service.PostService, comments *service.CommentService, votes *service.VoteService, cfg ResolverConfig) *Resolver {
 	return &Resolver{
 		postsService:   posts,
 		commentService: comments,
+		voteService:    votes,
+		cfg:            cfg,
+	}
+}
+
+// withActor определяет пользователя, от имени которого выполняется мутация.
+func (r *Resolver) withActor(ctx context.Context, userID *string) (context.Context, error) {
+	if uid, ok := auth.UserIDFromContext(ctx); ok {
+		if userID != nil && *userID != "" && *userID != strconv.FormatInt(uid, 10) {
+			return ctx, fmt.Errorf("userId does not match authenticated user: %w", service.ErrForbidden)
+		}
+		return ctx, nil
+	}
+
+	if !r.cfg.LegacyUserIDArgs || userID == nil || *userID == "" {
+		return ctx, service.ErrUnauthenticated
+	}
+
+	uid, err := strconv.ParseInt(*userID, 10, 64)
+	if err != nil || uid <= 0 {
+		return ctx, fmt.Errorf("invalid userId: %w", service.ErrInvalidRequest)
 	}
+	return auth.WithUserID(ctx, uid), nil
 }
@@ -6,20 +6,67 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	gqlmodel "myreddit/internal/adapter/in/graphql/model"
+	"myreddit/internal/model"
 	"myreddit/internal/service"
-	"strconv"
+	"sync"
 )
 
+// ViewerVote is the resolver for the viewerVote field.
+func (r *commentResolver) ViewerVote(ctx context.Context, obj *gqlmodel.Comment) (*int, error) {
+	cid, err := r.commentID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := r.loadViewerVote(ctx, model.VoteTargetComment, cid)
+	if err != nil {
+		return nil, err
+	}
+	return toVoteValue(v), nil
+}
+
+// Post is the resolver for the post field.
+func (r *commentResolver) Post(ctx context.Context, obj *gqlmodel.Comment) (*gqlmodel.Post, error) {
+	pid, err := r.postID(obj.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := r.loadPost(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	return toPostNode(p), nil
+}
+
+// Parent is the resolver for the parent field.
+func (r *commentResolver) Parent(ctx context.Context, obj *gqlmodel.Comment) (*gqlmodel.Comment, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+	cid, err := r.commentID(*obj.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := r.loadComment(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	return toCommentNode(c), nil
+}
+
 // CreatePost is the resolver for the createPost field.
-func (r *mutationResolver) CreatePost(ctx context.Context, title string, body string, userID string) (*gqlmodel.Post, error) {
-	uid, err := strconv.ParseInt(userID, 10, 64)
+func (r *mutationResolver) CreatePost(ctx context.Context, title string, body string, userID *string) (*gqlmodel.Post, error) {
+	ctx, err := r.withActor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	out, err := r.postsService.CreatePost(ctx, service.CreatePostRequest{
-		UserID:          uid,
 		Title:           title,
 		Text:            body,
 		CommentsEnabled: true,
@@ -31,17 +78,17 @@ func (r *mutationResolver) CreatePost(ctx context.Context, title string, body st
 }
 
 // SetCommentsEnabled is the resolver for the setCommentsEnabled field.
-func (r *mutationResolver) SetCommentsEnabled(ctx context.Context, postID string, userID string, enabled bool) (*gqlmodel.Post, error) {
-	pid, err := strconv.ParseInt(postID, 10, 64)
+func (r *mutationResolver) SetCommentsEnabled(ctx context.Context, postID string, userID *string, enabled bool) (*gqlmodel.Post, error) {
+	pid, err := r.postID(postID)
 	if err != nil {
 		return nil, err
 	}
-	uid, err := strconv.ParseInt(userID, 10, 64)
+	ctx, err = r.withActor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := r.postsService.ChangePostCommentPermission(ctx, pid, uid, enabled); err != nil {
+	if err := r.postsService.ChangePostCommentPermission(ctx, pid, enabled); err != nil {
 		return nil, err
 	}
 	p, err := r.postsService.GetPostByID(ctx, pid)
@@ -52,19 +99,19 @@ func (r *mutationResolver) SetCommentsEnabled(ctx context.Context, postID string
 }
 
 // CreateComment is the resolver for the createComment field.
-func (r *mutationResolver) CreateComment(ctx context.Context, postID string, parentID *string, userID string, body string) (*gqlmodel.Comment, error) {
-	pid, err := strconv.ParseInt(postID, 10, 64)
+func (r *mutationResolver) CreateComment(ctx context.Context, postID string, parentID *string, userID *string, body string) (*gqlmodel.Comment, error) {
+	pid, err := r.postID(postID)
 	if err != nil {
 		return nil, err
 	}
-	uid, err := strconv.ParseInt(userID, 10, 64)
+	ctx, err = r.withActor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	var parent *int64
 	if parentID != nil && *parentID != "" {
-		v, err := strconv.ParseInt(*parentID, 10, 64)
+		v, err := r.commentID(*parentID)
 		if err != nil {
 			return nil, err
 		}
@@ -74,7 +121,6 @@ func (r *mutationResolver) CreateComment(ctx context.Context, postID string, par
 	out, err := r.commentService.CreateComment(ctx, service.CreateCommentRequest{
 		PostID:   pid,
 		ParentID: parent,
-		UserID:   uid,
 		Text:     body,
 	})
 	if err != nil {
@@ -83,13 +129,155 @@ func (r *mutationResolver) CreateComment(ctx context.Context, postID string, par
 	return toCommentNode(out), nil
 }
 
+// UpdatePost is the resolver for the updatePost field.
+func (r *mutationResolver) UpdatePost(ctx context.Context, id string, title *string, body *string) (*gqlmodel.Post, error) {
+	pid, err := r.postID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.postsService.UpdatePost(ctx, service.UpdatePostRequest{
+		PostID: pid,
+		Title:  title,
+		Text:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPostNode(out), nil
+}
+
+// DeletePost is the resolver for the deletePost field.
+func (r *mutationResolver) DeletePost(ctx context.Context, id string) (bool, error) {
+	pid, err := r.postID(id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.postsService.DeletePost(ctx, pid); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// EditComment is the resolver for the editComment field.
+func (r *mutationResolver) EditComment(ctx context.Context, id string, body string) (*gqlmodel.Comment, error) {
+	cid, err := r.commentID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.commentService.EditComment(ctx, service.EditCommentRequest{
+		CommentID: cid,
+		Text:      body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCommentNode(out), nil
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (*gqlmodel.Comment, error) {
+	cid, err := r.commentID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.commentService.DeleteComment(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	return toCommentNode(out), nil
+}
+
+// VotePost is the resolver for the votePost field.
+func (r *mutationResolver) VotePost(ctx context.Context, targetID string, value int) (*gqlmodel.Post, error) {
+	pid, err := r.postID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := toVoteRequestValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.voteService.VotePost(ctx, service.VoteRequest{TargetID: pid, Value: v})
+	if err != nil {
+		return nil, err
+	}
+	return toPostNode(out), nil
+}
+
+// VoteComment is the resolver for the voteComment field.
+func (r *mutationResolver) VoteComment(ctx context.Context, targetID string, value int) (*gqlmodel.Comment, error) {
+	cid, err := r.commentID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := toVoteRequestValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.voteService.VoteComment(ctx, service.VoteRequest{TargetID: cid, Value: v})
+	if err != nil {
+		return nil, err
+	}
+	return toCommentNode(out), nil
+}
+
+// ViewerVote is the resolver for the viewerVote field.
+func (r *postResolver) ViewerVote(ctx context.Context, obj *gqlmodel.Post) (*int, error) {
+	pid, err := r.postID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := r.loadViewerVote(ctx, model.VoteTargetPost, pid)
+	if err != nil {
+		return nil, err
+	}
+	return toVoteValue(v), nil
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (gqlmodel.Node, error) {
+	return r.loadNode(ctx, id)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]gqlmodel.Node, error) {
+	if len(ids) > maxNodes {
+		return nil, fmt.Errorf("too many ids: at most %d: %w", maxNodes, service.ErrInvalidRequest)
+	}
+
+	out := make([]gqlmodel.Node, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		// загрузки параллельно, чтобы dataloader собрал их в один батч
+		go func() {
+			defer wg.Done()
+			out[i], errs[i] = r.loadNode(ctx, id)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Post is the resolver for the post field.
 func (r *queryResolver) Post(ctx context.Context, id string) (*gqlmodel.Post, error) {
-	pid, err := strconv.ParseInt(id, 10, 64)
+	pid, err := r.postID(id)
 	if err != nil {
 		return nil, err
 	}
-	p, err := r.postsService.GetPostByID(ctx, pid)
+	p, err := r.loadPost(ctx, pid)
 	if err != nil {
 		return nil, err
 	}
@@ -97,170 +285,255 @@ func (r *queryResolver) Post(ctx context.Context, id string) (*gqlmodel.Post, er
 }
 
 // Posts is the resolver for the posts field.
-func (r *queryResolver) Posts(ctx context.Context, page *gqlmodel.PageInput) (*gqlmodel.PostConnection, error) {
-	req := toPageRequest(page)
-	pg, err := r.postsService.GetPosts(ctx, req)
+func (r *queryResolver) Posts(ctx context.Context, page *gqlmodel.PageInput, sort *gqlmodel.PostSort, window *gqlmodel.TimeWindow) (*gqlmodel.PostConnection, error) {
+	req, err := toPageRequest(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	postSort := toPostSort(sort)
+	pg, err := r.postsService.GetPosts(ctx, req, postSort, toTimeWindow(window))
 	if err != nil {
 		return nil, err
 	}
 
 	edges := make([]*gqlmodel.PostEdge, 0, len(pg.Items))
 	nodes := make([]*gqlmodel.Post, 0, len(pg.Items))
-	for _, it := range pg.Items {
+	for i, it := range pg.Items {
 		n := toPostNode(it)
 		edges = append(edges, &gqlmodel.PostEdge{
-			Cursor: *encodeCursor(it.ID, it.CreatedAt),
+			Cursor: pg.Cursors[i],
 			Node:   n,
 		})
 		nodes = append(nodes, n)
 	}
 
 	return &gqlmodel.PostConnection{
-		Edges: edges,
-		Nodes: nodes,
-		PageInfo: &gqlmodel.PageInfo{
-			StartCursor: pg.StartCursor,
-			EndCursor:   pg.EndCursor,
-			HasNextPage: pg.HasNextPage,
-			Count:       pg.Count,
-		},
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   toPageInfo(pg),
+		TotalCount: pg.TotalCount,
 	}, nil
 }
 
 // Comments is the resolver for the comments field.
-func (r *queryResolver) Comments(ctx context.Context, postID string, page *gqlmodel.PageInput) (*gqlmodel.CommentConnection, error) {
-	pid, err := strconv.ParseInt(postID, 10, 64)
+func (r *queryResolver) Comments(ctx context.Context, postID string, page *gqlmodel.PageInput, sort *gqlmodel.CommentSort, includeReplies *bool) (*gqlmodel.CommentConnection, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := toPageRequest(ctx, page)
 	if err != nil {
 		return nil, err
 	}
-	req := toPageRequest(page)
-	pg, err := r.commentService.GetCommentsByPost(ctx, req, pid)
+	commentSort := toCommentSort(sort)
+	pg, err := r.commentService.GetCommentsByPost(ctx, req, pid, commentSort, includeReplies != nil && *includeReplies)
 	if err != nil {
 		return nil, err
 	}
 
 	edges := make([]*gqlmodel.CommentEdge, 0, len(pg.Items))
 	nodes := make([]*gqlmodel.Comment, 0, len(pg.Items))
-	for _, it := range pg.Items {
+	for i, it := range pg.Items {
 		n := toCommentNode(it)
 		edges = append(edges, &gqlmodel.CommentEdge{
-			Cursor: *encodeCursor(it.ID, it.CreatedAt),
+			Cursor: pg.Cursors[i],
 			Node:   n,
 		})
 		nodes = append(nodes, n)
 	}
 
 	return &gqlmodel.CommentConnection{
-		Edges: edges,
-		Nodes: nodes,
-		PageInfo: &gqlmodel.PageInfo{
-			StartCursor: pg.StartCursor,
-			EndCursor:   pg.EndCursor,
-			HasNextPage: pg.HasNextPage,
-			Count:       pg.Count,
-		},
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   toPageInfo(pg),
+		TotalCount: pg.TotalCount,
 	}, nil
 }
 
 // Replies is the resolver for the replies field.
-func (r *queryResolver) Replies(ctx context.Context, postID string, parentID string, page *gqlmodel.PageInput) (*gqlmodel.CommentConnection, error) {
-	pid, err := strconv.ParseInt(postID, 10, 64)
+func (r *queryResolver) Replies(ctx context.Context, postID string, parentID string, page *gqlmodel.PageInput, sort *gqlmodel.CommentSort) (*gqlmodel.CommentConnection, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
+	par, err := r.commentID(parentID)
 	if err != nil {
 		return nil, err
 	}
-	par, err := strconv.ParseInt(parentID, 10, 64)
+	req, err := toPageRequest(ctx, page)
 	if err != nil {
 		return nil, err
 	}
-	req := toPageRequest(page)
-	pg, err := r.commentService.GetReplies(ctx, req, pid, par)
+	commentSort := toCommentSort(sort)
+	pg, err := r.commentService.GetReplies(ctx, req, pid, par, commentSort)
 	if err != nil {
 		return nil, err
 	}
 
 	edges := make([]*gqlmodel.CommentEdge, 0, len(pg.Items))
 	nodes := make([]*gqlmodel.Comment, 0, len(pg.Items))
-	for _, it := range pg.Items {
+	for i, it := range pg.Items {
 		n := toCommentNode(it)
 		edges = append(edges, &gqlmodel.CommentEdge{
-			Cursor: *encodeCursor(it.ID, it.CreatedAt),
+			Cursor: pg.Cursors[i],
 			Node:   n,
 		})
 		nodes = append(nodes, n)
 	}
 
 	return &gqlmodel.CommentConnection{
-		Edges: edges,
-		Nodes: nodes,
-		PageInfo: &gqlmodel.PageInfo{
-			StartCursor: pg.StartCursor,
-			EndCursor:   pg.EndCursor,
-			HasNextPage: pg.HasNextPage,
-			Count:       pg.Count,
-		},
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   toPageInfo(pg),
+		TotalCount: pg.TotalCount,
+	}, nil
+}
+
+// Ancestors is the resolver for the ancestors field.
+func (r *queryResolver) Ancestors(ctx context.Context, commentID string) ([]*gqlmodel.Comment, error) {
+	cid, err := r.commentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.commentService.GetAncestors(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*gqlmodel.Comment, 0, len(items))
+	for _, it := range items {
+		out = append(out, toCommentNode(it))
+	}
+	return out, nil
+}
+
+// CommentTree is the resolver for the commentTree field.
+func (r *queryResolver) CommentTree(ctx context.Context, postID string, maxDepth *int, maxChildrenPerNode *int, sort *gqlmodel.CommentSort) (*gqlmodel.CommentTree, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := service.CommentTreeRequest{PostID: pid, Sort: toCommentSort(sort)}
+	if maxDepth != nil {
+		req.MaxDepth = *maxDepth
+	}
+	if maxChildrenPerNode != nil {
+		req.MaxChildren = *maxChildrenPerNode
+	}
+
+	tree, err := r.commentService.GetCommentTree(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.CommentTree{
+		Nodes: toCommentTreeNodes(tree.Roots),
+		More:  toMoreComments(tree.More),
 	}, nil
 }
 
 // CommentAdded is the resolver for the commentAdded field.
 func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID string) (<-chan *gqlmodel.Comment, error) {
-	pid, err := strconv.ParseInt(postID, 10, 64)
+	pid, err := r.postID(postID)
 	if err != nil {
 		return nil, err
 	}
 
-	comments, err := r.commentService.Listen(ctx, pid)
+	events, err := r.commentService.Listen(ctx, pid, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	out := make(chan *gqlmodel.Comment, 1)
-	go func() {
-		defer close(out)
+	return forward(ctx, events, func(ev service.CommentAddedEvent) *gqlmodel.Comment {
+		return toCommentNode(ev.Comment)
+	}), nil
+}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case comment, ok := <-comments:
-				if !ok {
-					return
-				}
+// CommentAddedEvents is the resolver for the commentAddedEvents field.
+func (r *subscriptionResolver) CommentAddedEvents(ctx context.Context, postID string, after *string) (<-chan *gqlmodel.CommentAddedEvent, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
 
-				out <- &gqlmodel.Comment{
-					ID:        strconv.FormatInt(comment.ID, 10),
-					PostID:    strconv.FormatInt(comment.PostID, 10),
-					ParentID:  toPtrString(comment.ParentID),
-					UserID:    strconv.FormatInt(comment.UserID, 10),
-					Body:      comment.Body,
-					CreatedAt: comment.CreatedAt,
-				}
+	events, err := r.commentService.Listen(ctx, pid, after)
+	if err != nil {
+		return nil, err
+	}
 
-			}
-		}
-	}()
+	return forward(ctx, events, func(ev service.CommentAddedEvent) *gqlmodel.CommentAddedEvent {
+		return &gqlmodel.CommentAddedEvent{Cursor: ev.Cursor, Node: toCommentNode(ev.Comment), Missed: ev.Missed}
+	}), nil
+}
 
-	return out, nil
+// PostAdded is the resolver for the postAdded field.
+func (r *subscriptionResolver) PostAdded(ctx context.Context) (<-chan *gqlmodel.Post, error) {
+	posts, err := r.postsService.ListenPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, posts, toPostNode), nil
+}
+
+// PostUpdated is the resolver for the postUpdated field.
+func (r *subscriptionResolver) PostUpdated(ctx context.Context, postID string) (<-chan gqlmodel.PostUpdate, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := r.postsService.ListenPost(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, changes, toPostUpdate), nil
+}
+
+// CommentChanged is the resolver for the commentChanged field.
+func (r *subscriptionResolver) CommentChanged(ctx context.Context, postID string) (<-chan gqlmodel.CommentChange, error) {
+	pid, err := r.postID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := r.commentService.ListenChanges(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, changes, toCommentChange), nil
+}
 
-	// panic(fmt.Errorf("not implemented: CommentAdded - commentAdded"))
+// RepliesAdded is the resolver for the repliesAdded field.
+func (r *subscriptionResolver) RepliesAdded(ctx context.Context, commentID string, includeDescendants *bool) (<-chan *gqlmodel.Comment, error) {
+	cid, err := r.commentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := r.commentService.ListenReplies(ctx, cid, includeDescendants != nil && *includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+	return forward(ctx, replies, toCommentNode), nil
 }
 
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Post returns PostResolver implementation.
+func (r *Resolver) Post() PostResolver { return &postResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
+type commentResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type postResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
-
-func toPtrString(id *int64) *string {
-	if id == nil {
-		return nil
-	}
-	s := strconv.FormatInt(*id, 10)
-	return &s
-}
@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"myreddit/internal/service"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type streamErrorKey struct{}
+
+// streamError — ошибка, с которой закрылся поток подписки
+type streamError struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (e *streamError) set(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.err = err
+}
+
+func (e *streamError) take() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	err := e.err
+	e.err = nil
+	return err
+}
+
+// StreamErrors отдает клиенту ошибку, с которой закрылся поток подписки.
+func StreamErrors(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+
+	se := &streamError{}
+	responses := next(context.WithValue(ctx, streamErrorKey{}, se))
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			if err := se.take(); err != nil {
+				return &graphql.Response{Errors: gqlerror.List{ErrorPresenter(ctx, err)}}
+			}
+		}
+		return resp
+	}
+}
+
+// forward переводит события сервиса в ответы подписки.
+func forward[T, U any](ctx context.Context, in *service.Stream[T], conv func(T) U) <-chan U {
+	out := make(chan U, 1)
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in.C:
+				if !ok {
+					if se, ok := ctx.Value(streamErrorKey{}).(*streamError); ok && in.Err() != nil {
+						se.set(in.Err())
+					}
+					return
+				}
+
+				select {
+				case out <- conv(v):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
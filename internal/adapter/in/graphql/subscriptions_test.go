@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/pagination"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubscription_LaggedError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	posts := service.NewMockPostStorage(ctrl)
+	bus := service.NewMockEventBus(ctrl)
+	cursors, err := pagination.NewCodec([]byte("test-secret-test-secret-test-sec"))
+	require.NoError(t, err)
+
+	// шина отдает одно событие и отключает медленного подписчика
+	live := make(chan service.EventMessage, 2)
+	live <- service.EventMessage{Event: service.Event{
+		Topic: service.CommentsTopic(1), Kind: service.EventCreated, PostID: 1, CommentID: 5,
+		Comment: &model.Comment{ID: 5, PostID: 1, UserID: 7, Body: "hi"},
+	}}
+	live <- service.EventMessage{Missed: 2, Err: service.ErrSubscriptionLagged}
+	close(live)
+	posts.EXPECT().GetPostByID(gomock.Any(), int64(1)).Return(model.Post{ID: 1}, nil)
+	bus.EXPECT().Subscribe(gomock.Any(), service.CommentsTopic(1)).Return((<-chan service.EventMessage)(live), nil)
+
+	comments := service.NewCommentService(nil, bus, posts, nil, nil, cursors, service.CommentConfig{})
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: NewResolver(nil, comments, nil, ResolverConfig{})}))
+	srv.SetErrorPresenter(ErrorPresenter)
+	srv.AroundOperations(StreamErrors)
+	srv.AddTransport(transport.Websocket{KeepAlivePingInterval: time.Second})
+
+	sub := client.New(srv).Websocket(`subscription($id: ID!) { commentAdded(postId: $id) { body } }`,
+		client.Var("id", postGlobalID(1)))
+	defer sub.Close()
+
+	var resp struct{ CommentAdded struct{ Body string } }
+	require.NoError(t, sub.Next(&resp))
+	require.Equal(t, "hi", resp.CommentAdded.Body)
+
+	// отключение приходит ошибкой с кодом, а не молчаливым завершением
+	err = sub.Next(&resp)
+	var gqlErr client.RawJsonError
+	require.ErrorAs(t, err, &gqlErr)
+	var errs []struct {
+		Extensions map[string]any `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(gqlErr.RawMessage, &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, CodeSubscriptionLagged, errs[0].Extensions["code"])
+}
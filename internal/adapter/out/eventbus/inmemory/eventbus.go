@@ -0,0 +1,273 @@
+package inmemory
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"sync"
+
+	"myreddit/internal/service"
+)
+
+// SlowPolicy — что делать с событием, если очередь подписчика заполнена
+type SlowPolicy string
+
+const (
+	// DropOldest вытесняет самое старое событие очереди
+	DropOldest SlowPolicy = "drop-oldest"
+	// DropNewest отбрасывает новое событие
+	DropNewest SlowPolicy = "drop-newest"
+	// Disconnect закрывает подписку сообщением с service.ErrSubscriptionLagged
+	Disconnect SlowPolicy = "disconnect"
+)
+
+const DefaultBufferSize = 64
+
+func ParseSlowPolicy(s string) (SlowPolicy, error) {
+	switch p := SlowPolicy(s); p {
+	case DropOldest, DropNewest, Disconnect:
+		return p, nil
+	case "":
+		return DropOldest, nil
+	default:
+		return "", fmt.Errorf("unknown slow subscriber policy %q", s)
+	}
+}
+
+type Config struct {
+	// BufferSize — длина очереди подписчика, 0 — DefaultBufferSize
+	BufferSize int
+	// Policy — поведение при заполненной очереди, пусто — DropOldest
+	Policy SlowPolicy
+}
+
+// EventBus раздает события подписчикам своего процесса
+type EventBus struct {
+	cfg Config
+
+	mu sync.RWMutex
+	// топик -> подписчики
+	subs map[string]map[*subscriber]struct{}
+
+	stats *expvar.Map
+	// dropped — потерянные события по топикам, disconnected — закрытые медленные подписки
+	dropped      *expvar.Map
+	disconnected *expvar.Int
+}
+
+func New(cfg Config) *EventBus {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultBufferSize
+	}
+	if cfg.Policy == "" {
+		cfg.Policy = DropOldest
+	}
+
+	b := &EventBus{
+		cfg:          cfg,
+		subs:         make(map[string]map[*subscriber]struct{}),
+		stats:        new(expvar.Map).Init(),
+		dropped:      new(expvar.Map).Init(),
+		disconnected: new(expvar.Int),
+	}
+	b.stats.Set("dropped", b.dropped)
+	b.stats.Set("disconnected", b.disconnected)
+	return b
+}
+
+// Stats — счетчики шины для публикации через expvar
+func (b *EventBus) Stats() *expvar.Map {
+	return b.stats
+}
+
+func (b *EventBus) Subscribe(ctx context.Context, topic string) (<-chan service.EventMessage, error) {
+	sub := &subscriber{
+		out:  make(chan service.EventMessage),
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[*subscriber]struct{})
+	}
+	b.subs[topic][sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		defer close(sub.out)
+		defer b.remove(topic, sub)
+		sub.pump(ctx)
+	}()
+
+	return sub.out, nil
+}
+
+// Publish раздает событие подписчикам всех его топиков, см. service.Event.Routes.
+func (b *EventBus) Publish(_ context.Context, e service.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, topic := range e.Routes() {
+		for sub := range b.subs[topic] {
+			if sub.push(service.EventMessage{Event: e}, b.cfg) {
+				b.dropped.Add(topic, 1)
+				if b.cfg.Policy == Disconnect {
+					b.disconnected.Add(1)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Gap сообщает всем подписчикам, что события могли потеряться.
+func (b *EventBus) Gap() {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, set := range b.subs {
+		for sub := range set {
+			sub.markGap()
+		}
+	}
+}
+
+func (b *EventBus) remove(topic string, sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if set := b.subs[topic]; set != nil {
+		delete(set, sub)
+		if len(set) == 0 {
+			delete(b.subs, topic)
+		}
+	}
+}
+
+// subscriber — очередь событий подписчика; pump отдает ее в out, не блокируя Publish
+type subscriber struct {
+	mu    sync.Mutex
+	queue []service.EventMessage
+	// missed — потерянные события, которые еще не к чему приписать
+	missed int
+	closed bool
+
+	out  chan service.EventMessage
+	wake chan struct{}
+	// done закрывается, когда медленная подписка отключается
+	done chan struct{}
+}
+
+// push ставит сообщение в очередь и сообщает, потеряно ли событие
+func (s *subscriber) push(msg service.EventMessage, cfg Config) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	msg.Missed += s.missed
+	s.missed = 0
+	if len(s.queue) < cfg.BufferSize {
+		s.enqueue(msg)
+		return false
+	}
+
+	switch cfg.Policy {
+	case DropNewest:
+		s.missed = msg.Missed + 1
+	case Disconnect:
+		s.closed = true
+		s.missed = msg.Missed + len(s.queue) + 1
+		s.queue = nil
+		close(s.done)
+	default:
+		// потеря и отметка разрыва переходят к сообщению, которое теперь идет первым
+		lost, gap := 1+s.queue[0].Missed, s.queue[0].Gap
+		s.queue = s.queue[1:]
+		if len(s.queue) > 0 {
+			s.queue[0].Missed += lost
+			s.queue[0].Gap = s.queue[0].Gap || gap
+		} else {
+			msg.Missed += lost
+			msg.Gap = msg.Gap || gap
+		}
+		s.enqueue(msg)
+	}
+	return true
+}
+
+// markGap отмечает разрыв в очереди подписчика.
+func (s *subscriber) markGap() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	if len(s.queue) > 0 {
+		s.queue[len(s.queue)-1].Gap = true
+		return
+	}
+	s.enqueue(service.EventMessage{Gap: true})
+}
+
+func (s *subscriber) enqueue(msg service.EventMessage) {
+	s.queue = append(s.queue, msg)
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscriber) pop() (service.EventMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queue) == 0 {
+		return service.EventMessage{}, false
+	}
+	msg := s.queue[0]
+	s.queue = s.queue[1:]
+	return msg, true
+}
+
+func (s *subscriber) pump(ctx context.Context) {
+	for {
+		msg, ok := s.pop()
+		if !ok {
+			select {
+			case <-s.wake:
+				continue
+			case <-s.done:
+				s.disconnect(ctx, 0)
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case s.out <- msg:
+		case <-s.done:
+			s.disconnect(ctx, 1+msg.Missed)
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// disconnect отдает подписчику причину отключения и число потерянных событий
+func (s *subscriber) disconnect(ctx context.Context, lost int) {
+	s.mu.Lock()
+	msg := service.EventMessage{Missed: s.missed + lost, Err: service.ErrSubscriptionLagged}
+	s.mu.Unlock()
+
+	select {
+	case s.out <- msg:
+	case <-ctx.Done():
+	}
+}
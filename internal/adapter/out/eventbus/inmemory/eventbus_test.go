@@ -0,0 +1,207 @@
+package inmemory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+
+	"github.com/stretchr/testify/require"
+)
+
+func queued(s *subscriber) (ids []int64, missed []int) {
+	for _, msg := range s.queue {
+		ids = append(ids, msg.Event.CommentID)
+		missed = append(missed, msg.Missed)
+	}
+	return ids, missed
+}
+
+func TestSubscriber_Push(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		policy     SlowPolicy
+		wantIDs    []int64
+		wantMissed []int
+		wantClosed bool
+	}{
+		// потеря приписана сообщению, ставшему первым в очереди
+		{name: "drop oldest", policy: DropOldest, wantIDs: []int64{3, 4}, wantMissed: []int{2, 0}},
+		// потеря приписывается следующему сообщению, которое попадет в очередь
+		{name: "drop newest", policy: DropNewest, wantIDs: []int64{1, 2}, wantMissed: []int{0, 0}},
+		// очередь сбрасывается, потери отдаются последним сообщением
+		{name: "disconnect", policy: Disconnect, wantClosed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &subscriber{wake: make(chan struct{}, 1), done: make(chan struct{})}
+			cfg := Config{BufferSize: 2, Policy: tt.policy}
+
+			var lost int
+			for id := int64(1); id <= 4; id++ {
+				if s.push(service.EventMessage{Event: service.Event{CommentID: id}}, cfg) {
+					lost++
+				}
+			}
+
+			ids, missed := queued(s)
+			require.Equal(t, tt.wantIDs, ids)
+			require.Equal(t, tt.wantMissed, missed)
+			require.Equal(t, tt.wantClosed, s.closed)
+			if tt.wantClosed {
+				require.Equal(t, 1, lost)
+				require.Equal(t, 3, s.missed)
+			} else {
+				require.Equal(t, 2, lost)
+			}
+		})
+	}
+}
+
+func TestSubscriber_DropNewestCarriesMissed(t *testing.T) {
+	t.Parallel()
+
+	s := &subscriber{wake: make(chan struct{}, 1), done: make(chan struct{})}
+	cfg := Config{BufferSize: 1, Policy: DropNewest}
+
+	s.push(service.EventMessage{Event: service.Event{CommentID: 1}}, cfg)
+	s.push(service.EventMessage{Event: service.Event{CommentID: 2}}, cfg)
+	s.push(service.EventMessage{Event: service.Event{CommentID: 3}}, cfg)
+
+	msg, ok := s.pop()
+	require.True(t, ok)
+	require.Equal(t, 0, msg.Missed)
+
+	s.push(service.EventMessage{Event: service.Event{CommentID: 4}}, cfg)
+	msg, ok = s.pop()
+	require.True(t, ok)
+	require.Equal(t, int64(4), msg.Event.CommentID)
+	require.Equal(t, 2, msg.Missed)
+}
+
+func TestSubscriber_MarkGap(t *testing.T) {
+	t.Parallel()
+
+	s := &subscriber{wake: make(chan struct{}, 1), done: make(chan struct{})}
+	cfg := Config{BufferSize: 2, Policy: DropOldest}
+
+	// в пустой очереди разрыв — отдельное сообщение без события
+	s.markGap()
+	msg, ok := s.pop()
+	require.True(t, ok)
+	require.True(t, msg.Gap)
+	require.Zero(t, msg.Event.CommentID)
+
+	// иначе отметка ставится на последнее сообщение и не теряется при вытеснении
+	s.push(service.EventMessage{Event: service.Event{CommentID: 1}}, cfg)
+	s.markGap()
+	s.push(service.EventMessage{Event: service.Event{CommentID: 2}}, cfg)
+	s.push(service.EventMessage{Event: service.Event{CommentID: 3}}, cfg)
+	ids, missed := queued(s)
+	require.Equal(t, []int64{2, 3}, ids)
+	require.Equal(t, []int{1, 0}, missed)
+	require.True(t, s.queue[0].Gap)
+	require.False(t, s.queue[1].Gap)
+}
+
+func TestEventBus_Disconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := New(Config{BufferSize: 1, Policy: Disconnect})
+	sub, err := bus.Subscribe(ctx, "comments:10")
+	require.NoError(t, err)
+
+	// подписчик не читает: очередь переполняется, и шина его отключает
+	for id := int64(1); id <= 3; id++ {
+		require.NoError(t, bus.Publish(ctx, service.Event{Topic: "comments:10", CommentID: id}))
+	}
+
+	var last service.EventMessage
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case msg, ok := <-sub:
+			if !ok {
+				require.Equal(t, "1", bus.dropped.Get("comments:10").String())
+				require.Equal(t, int64(1), bus.disconnected.Value())
+				// последнее сообщение перед закрытием — причина и потери
+				require.ErrorIs(t, last.Err, service.ErrSubscriptionLagged)
+				require.Positive(t, last.Missed)
+				return
+			}
+			last = msg
+		case <-timeout:
+			t.Fatal("slow subscriber was not disconnected")
+		}
+	}
+}
+
+func TestEventBus_UnsubscribeOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := New(Config{})
+
+	sub, err := bus.Subscribe(ctx, "comments:10")
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, service.Event{Topic: "comments:10", CommentID: 1}))
+	require.Equal(t, int64(1), (<-sub).Event.CommentID)
+
+	cancel()
+	_, ok := <-sub
+	require.False(t, ok)
+
+	require.Eventually(t, func() bool {
+		bus.mu.RLock()
+		defer bus.mu.RUnlock()
+		return len(bus.subs) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEventBus_RoutesByThread(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := New(Config{})
+	subscribe := func(topic string) <-chan service.EventMessage {
+		sub, err := bus.Subscribe(ctx, topic)
+		require.NoError(t, err)
+		return sub
+	}
+	post := subscribe(service.CommentsTopic(10))
+	parent := subscribe(service.RepliesTopic(2))
+	root := subscribe(service.RepliesTopic(1))
+	thread := subscribe(service.ThreadTopic(1))
+
+	parentID := int64(2)
+	reply := model.Comment{ID: 3, PostID: 10, ParentID: &parentID, Path: []int64{1, 2}}
+	require.NoError(t, bus.Publish(ctx, service.Event{Topic: service.CommentsTopic(10), Kind: service.EventCreated, CommentID: 3, Comment: &reply}))
+
+	for _, sub := range []<-chan service.EventMessage{post, parent, thread} {
+		select {
+		case msg := <-sub:
+			require.Equal(t, int64(3), msg.Event.CommentID)
+		case <-time.After(2 * time.Second):
+			t.Fatal("event was not routed")
+		}
+	}
+
+	// ответ не прямой для корня: подписчик прямых ответов его не получает
+	select {
+	case msg := <-root:
+		t.Fatalf("unexpected event %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
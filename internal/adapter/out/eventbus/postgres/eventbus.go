@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"time"
+
+	"myreddit/internal/adapter/out/eventbus/inmemory"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/logger"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	DefaultChannel = "events"
+
+	// maxPayload — запас до предела NOTIFY в 8000 байт
+	maxPayload = 7500
+
+	reconnectMin = 100 * time.Millisecond
+	reconnectMax = 10 * time.Second
+)
+
+// PostReader и CommentReader перечитывают то, что не поместилось в payload
+type PostReader interface {
+	GetPostByID(ctx context.Context, id int64) (model.Post, error)
+}
+
+type CommentReader interface {
+	GetCommentByID(ctx context.Context, id int64) (model.Comment, error)
+}
+
+// Conn — выделенное соединение под LISTEN; *pgx.Conn подходит
+type Conn interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Close(ctx context.Context) error
+}
+
+// message — payload уведомления; Trimmed — пост и комментарий не поместились
+type message struct {
+	Topic     string            `json:"t"`
+	Kind      service.EventKind `json:"k"`
+	PostID    int64             `json:"p"`
+	CommentID int64             `json:"c,omitempty"`
+	Post      *model.Post       `json:"po,omitempty"`
+	Comment   *model.Comment    `json:"m,omitempty"`
+	Trimmed   bool              `json:"r,omitempty"`
+}
+
+// EventBus рассылает события между репликами через pg_notify.
+type EventBus struct {
+	db       trmpgx.Tr
+	getter   *trmpgx.CtxGetter
+	connect  func(ctx context.Context) (Conn, error)
+	posts    PostReader
+	comments CommentReader
+	channel  string
+
+	// local раздает полученные уведомления подписчикам процесса
+	local *inmemory.EventBus
+}
+
+// subscribers задает очередь и политику медленных подписчиков процесса
+func New(db trmpgx.Tr, getter *trmpgx.CtxGetter, connect func(ctx context.Context) (Conn, error), posts PostReader, comments CommentReader, channel string, subscribers inmemory.Config) *EventBus {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	return &EventBus{
+		db:       db,
+		getter:   getter,
+		connect:  connect,
+		posts:    posts,
+		comments: comments,
+		channel:  channel,
+		local:    inmemory.New(subscribers),
+	}
+}
+
+func (b *EventBus) Subscribe(ctx context.Context, topic string) (<-chan service.EventMessage, error) {
+	return b.local.Subscribe(ctx, topic)
+}
+
+// Stats — счетчики потерь у подписчиков процесса
+func (b *EventBus) Stats() *expvar.Map {
+	return b.local.Stats()
+}
+
+func (b *EventBus) Publish(ctx context.Context, e service.Event) error {
+	payload, err := encodeMessage(e)
+	if err != nil {
+		return err
+	}
+
+	tr := b.getter.DefaultTrOrDB(ctx, b.db)
+	if _, err := tr.Exec(ctx, "SELECT pg_notify($1, $2)", b.channel, payload); err != nil {
+		return fmt.Errorf("exec pg_notify: %w", err)
+	}
+	return nil
+}
+
+// Listen держит LISTEN на выделенном соединении и раздает уведомления подписчикам до отмены ctx.
+func (b *EventBus) Listen(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	delay, reconnect := reconnectMin, false
+	connected := func() {
+		delay = reconnectMin
+		if reconnect {
+			b.local.Gap()
+		}
+		reconnect = true
+	}
+	for {
+		err := b.listen(ctx, connected)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Error("event bus listener stopped, reconnecting", "error", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, reconnectMax)
+	}
+}
+
+// listen обслуживает одно соединение; connected вызывается после успешного LISTEN.
+func (b *EventBus) listen(ctx context.Context, connected func()) error {
+	conn, err := b.connect(ctx)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{b.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("exec listen: %w", err)
+	}
+	connected()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		b.dispatch(ctx, n.Payload)
+	}
+}
+
+// dispatch разбирает payload и отдает событие локальным подписчикам.
+func (b *EventBus) dispatch(ctx context.Context, payload string) {
+	log := logger.FromContext(ctx)
+
+	var m message
+	if err := json.Unmarshal([]byte(payload), &m); err != nil {
+		log.Error("invalid event bus payload", "error", err)
+		return
+	}
+
+	e := service.Event{Topic: m.Topic, Kind: m.Kind, PostID: m.PostID, CommentID: m.CommentID, Post: m.Post, Comment: m.Comment}
+	if m.Trimmed {
+		if err := b.refetch(ctx, &e); err != nil {
+			log.Error("error getting published event payload", "error", err, "topic", m.Topic, "post_id", m.PostID, "comment_id", m.CommentID)
+			return
+		}
+	}
+	_ = b.local.Publish(ctx, e)
+}
+
+// refetch перечитывает комментарий или пост события; у удаленного поста читать нечего.
+func (b *EventBus) refetch(ctx context.Context, e *service.Event) error {
+	switch {
+	case e.CommentID != 0:
+		c, err := b.comments.GetCommentByID(ctx, e.CommentID)
+		if err != nil {
+			return err
+		}
+		e.Comment = &c
+	case e.Kind != service.EventDeleted:
+		p, err := b.posts.GetPostByID(ctx, e.PostID)
+		if err != nil {
+			return err
+		}
+		e.Post = &p
+	}
+	return nil
+}
+
+// encodeMessage кладет событие в payload целиком, если оно помещается в maxPayload.
+func encodeMessage(e service.Event) (string, error) {
+	m := message{Topic: e.Topic, Kind: e.Kind, PostID: e.PostID, CommentID: e.CommentID, Post: e.Post, Comment: e.Comment}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("marshal event: %w", err)
+	}
+	if len(b) <= maxPayload {
+		return string(b), nil
+	}
+
+	m.Post, m.Comment, m.Trimmed = nil, nil, true
+	if b, err = json.Marshal(m); err != nil {
+		return "", fmt.Errorf("marshal event ids: %w", err)
+	}
+	return string(b), nil
+}
+
+// ConnectFrom открывает отдельные от пула соединения с теми же параметрами.
+func ConnectFrom(cfg *pgx.ConnConfig) func(ctx context.Context) (Conn, error) {
+	return func(ctx context.Context) (Conn, error) {
+		return pgx.ConnectConfig(ctx, cfg.Copy())
+	}
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"myreddit/internal/adapter/out/eventbus/inmemory"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConn отдает уведомления из канала; закрытый канал — обрыв соединения
+type fakeConn struct {
+	notes  chan string
+	mu     sync.Mutex
+	listen []string
+}
+
+func (c *fakeConn) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	c.mu.Lock()
+	c.listen = append(c.listen, sql)
+	c.mu.Unlock()
+	return pgconn.NewCommandTag("LISTEN"), nil
+}
+
+func (c *fakeConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case p, ok := <-c.notes:
+		if !ok {
+			return nil, errors.New("conn closed")
+		}
+		return &pgconn.Notification{Payload: p}, nil
+	}
+}
+
+func (c *fakeConn) Close(context.Context) error { return nil }
+
+type stubComments map[int64]model.Comment
+
+func (s stubComments) GetCommentByID(_ context.Context, id int64) (model.Comment, error) {
+	return s[id], nil
+}
+
+type stubPosts map[int64]model.Post
+
+func (s stubPosts) GetPostByID(_ context.Context, id int64) (model.Post, error) {
+	return s[id], nil
+}
+
+func commentEvent(c model.Comment) service.Event {
+	return service.Event{Topic: service.CommentsTopic(c.PostID), Kind: service.EventCreated, PostID: c.PostID, CommentID: c.ID, Comment: &c}
+}
+
+func TestEncodeMessage(t *testing.T) {
+	small := model.Comment{ID: 1, PostID: 10, Body: "hi"}
+	payload, err := encodeMessage(commentEvent(small))
+	require.NoError(t, err)
+
+	var m message
+	require.NoError(t, json.Unmarshal([]byte(payload), &m))
+	require.Equal(t, "comments:10", m.Topic)
+	require.Equal(t, service.EventCreated, m.Kind)
+	require.Equal(t, small.Body, m.Comment.Body)
+	require.False(t, m.Trimmed)
+
+	// событие больше предела NOTIFY отправляется без комментария
+	big := model.Comment{ID: 2, PostID: 10, Body: strings.Repeat("😀", 2000)}
+	payload, err = encodeMessage(commentEvent(big))
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(payload), maxPayload)
+
+	m = message{}
+	require.NoError(t, json.Unmarshal([]byte(payload), &m))
+	require.Nil(t, m.Comment)
+	require.True(t, m.Trimmed)
+	require.Equal(t, int64(2), m.CommentID)
+}
+
+func TestEventBus_Publish(t *testing.T) {
+	pool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer pool.Close()
+
+	e := commentEvent(model.Comment{ID: 1, PostID: 10, Body: "hi"})
+	payload, err := encodeMessage(e)
+	require.NoError(t, err)
+
+	pool.ExpectExec("SELECT pg_notify").
+		WithArgs("events_test", payload).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+	bus := New(pool, trmpgx.DefaultCtxGetter, nil, nil, nil, "events_test", inmemory.Config{})
+	require.NoError(t, bus.Publish(context.Background(), e))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+func TestEventBus_ListenReconnects(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := &fakeConn{notes: make(chan string, 1)}
+	second := &fakeConn{notes: make(chan string, 3)}
+	conns := make(chan Conn, 2)
+	conns <- first
+	conns <- second
+	connect := func(context.Context) (Conn, error) { return <-conns, nil }
+
+	posts := stubPosts{10: {ID: 10, Title: "refetched post"}}
+	comments := stubComments{2: {ID: 2, PostID: 10, Body: "refetched"}}
+	bus := New(nil, trmpgx.DefaultCtxGetter, connect, posts, comments, "", inmemory.Config{})
+
+	sub, err := bus.Subscribe(ctx, service.CommentsTopic(10))
+	require.NoError(t, err)
+	postSub, err := bus.Subscribe(ctx, service.PostTopic(10))
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() { done <- bus.Listen(ctx) }()
+
+	inline, err := encodeMessage(commentEvent(model.Comment{ID: 1, PostID: 10, Body: "inline"}))
+	require.NoError(t, err)
+	first.notes <- inline
+	require.Equal(t, "inline", receive(t, sub).Comment.Body)
+
+	// после обрыва шина переподключается, отмечает разрыв и перечитывает
+	// урезанные события по id
+	close(first.notes)
+	require.True(t, receiveMsg(t, sub).Gap)
+	require.True(t, receiveMsg(t, postSub).Gap)
+	second.notes <- `{"t":"comments:10","k":"edited","p":10,"c":2,"r":true}`
+	second.notes <- `{"t":"post:10","k":"edited","p":10,"r":true}`
+	second.notes <- `{"t":"post:10","k":"deleted","p":10,"r":true}`
+	require.Equal(t, "refetched", receive(t, sub).Comment.Body)
+	require.Equal(t, "refetched post", receive(t, postSub).Post.Title)
+	require.Nil(t, receive(t, postSub).Post)
+	require.Equal(t, []string{`LISTEN "events"`}, second.listen)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func receive(t *testing.T, ch <-chan service.EventMessage) service.Event {
+	t.Helper()
+	return receiveMsg(t, ch).Event
+}
+
+func receiveMsg(t *testing.T, ch <-chan service.EventMessage) service.EventMessage {
+	t.Helper()
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered")
+		return service.EventMessage{}
+	}
+}
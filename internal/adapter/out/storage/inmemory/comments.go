@@ -1,11 +1,14 @@
 package inmemory
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"myreddit/internal/adapter/out/storage"
 	"myreddit/internal/model"
 	"myreddit/internal/service"
+	"myreddit/pkg/pagination"
+	"myreddit/pkg/ranking"
 	"slices"
 	"sync"
 	"time"
@@ -16,6 +19,8 @@ type CommentStorage struct {
 
 	comments []model.Comment
 	byPost   map[int64][]int64
+	// roots — корневые комментарии поста, byParent — ответы на комментарий
+	roots    map[int64][]int64
 	byParent map[int64][]int64
 }
 
@@ -23,6 +28,7 @@ func NewCommentStorage() *CommentStorage {
 	return &CommentStorage{
 		comments: []model.Comment{{}},
 		byPost:   make(map[int64][]int64),
+		roots:    make(map[int64][]int64),
 		byParent: make(map[int64][]int64),
 	}
 }
@@ -38,15 +44,28 @@ func (s *CommentStorage) CreateComment(_ context.Context, req service.CreateComm
 		Body:      req.Text,
 		CreatedAt: time.Now(),
 	}
+	rankComment(&c)
 	if req.ParentID != nil {
-		pid := *req.ParentID
-		c.ParentID = &pid
+		// то же, что внешний ключ (post_id, parent_id) в postgres
+		parent, ok := s.get(*req.ParentID)
+		if !ok {
+			return model.Comment{}, service.ErrNotFound
+		}
+		if parent.PostID != req.PostID {
+			return model.Comment{}, service.ErrParentPostMismatch
+		}
+		c.ParentID = &parent.ID
+		c.Path = append(slices.Clone(parent.Path), parent.ID)
+		c.Depth = parent.Depth + 1
 	}
 
 	s.comments = append(s.comments, c)
 	s.byPost[c.PostID] = append(s.byPost[c.PostID], c.ID)
 	if c.ParentID != nil {
 		s.byParent[*c.ParentID] = append(s.byParent[*c.ParentID], c.ID)
+		s.comments[*c.ParentID].ReplyCount++
+	} else {
+		s.roots[c.PostID] = append(s.roots[c.PostID], c.ID)
 	}
 
 	return c, nil
@@ -56,24 +75,38 @@ func (s *CommentStorage) GetCommentByID(_ context.Context, commentID int64) (mod
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if commentID <= 0 || int(commentID) >= len(s.comments) {
-		return model.Comment{}, service.ErrNotFound
-	}
-	c := s.comments[commentID]
-	if c.ID == 0 {
+	c, ok := s.get(commentID)
+	if !ok {
 		return model.Comment{}, service.ErrNotFound
 	}
 	return c, nil
 }
 
-func (s *CommentStorage) GetCommentsByPost(_ context.Context, postID int64, limit int) ([]model.Comment, error) {
+func (s *CommentStorage) GetCommentsByIDs(_ context.Context, ids []int64) ([]model.Comment, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	ids := s.byPost[postID]
+	var out []model.Comment
+	for _, id := range ids {
+		if c, ok := s.get(id); ok {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (s *CommentStorage) GetCommentsByPost(_ context.Context, postID int64, feed storage.CommentFeed, limit int) ([]model.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := s.postIndex(postID, feed)
 	if len(ids) == 0 {
 		return nil, nil
 	}
+	if feed.Sort != model.CommentSortNew {
+		ranked := s.rankedComments(ids, postID, feed.Sort)
+		return ranked[:min(limit, len(ranked))], nil
+	}
 
 	out := make([]model.Comment, 0, min(limit, len(ids)))
 	for i := len(ids) - 1; i >= 0 && len(out) < limit; i-- {
@@ -83,7 +116,14 @@ func (s *CommentStorage) GetCommentsByPost(_ context.Context, postID int64, limi
 	return out, nil
 }
 
-func (s *CommentStorage) GetReplies(_ context.Context, postID, parentID int64, limit int) ([]model.Comment, error) {
+func (s *CommentStorage) CountComments(_ context.Context, postID int64, feed storage.CommentFeed) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.postIndex(postID, feed)), nil
+}
+
+func (s *CommentStorage) GetReplies(_ context.Context, postID, parentID int64, sort model.CommentSort, limit int) ([]model.Comment, error) {
 	if limit <= 0 {
 		limit = service.DefaultCommentsLimit
 	}
@@ -95,6 +135,10 @@ func (s *CommentStorage) GetReplies(_ context.Context, postID, parentID int64, l
 	if len(childIDs) == 0 {
 		return nil, nil
 	}
+	if sort != model.CommentSortNew {
+		ranked := s.rankedComments(childIDs, postID, sort)
+		return ranked[:min(limit, len(ranked))], nil
+	}
 
 	out := make([]model.Comment, 0, min(limit, len(childIDs)))
 	for i := len(childIDs) - 1; i >= 0 && len(out) < limit; i-- {
@@ -111,10 +155,13 @@ func (s *CommentStorage) GetCommentsByPostWithCursor(_ context.Context, p storag
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	ids := s.byPost[p.PostID]
+	ids := s.postIndex(p.PostID, p.Feed)
 	if len(ids) == 0 {
 		return nil, nil
 	}
+	if p.Feed.Sort != model.CommentSortNew {
+		return s.rankedCommentsWithCursor(ids, p.PostID, p.Feed.Sort, p.Cursor, p.Direction, p.Limit)
+	}
 
 	out := make([]model.Comment, 0, p.Limit)
 	switch p.Direction {
@@ -150,6 +197,9 @@ func (s *CommentStorage) GetRepliesWithCursor(_ context.Context, p storage.GetRe
 	if len(childIDs) == 0 {
 		return nil, nil
 	}
+	if p.Sort != model.CommentSortNew {
+		return s.rankedCommentsWithCursor(childIDs, p.PostID, p.Sort, p.Cursor, p.Direction, p.Limit)
+	}
 
 	out := make([]model.Comment, 0, p.Limit)
 	switch p.Direction {
@@ -182,3 +232,156 @@ func (s *CommentStorage) GetRepliesWithCursor(_ context.Context, p storage.GetRe
 		return nil, errors.New("invalid keyset direction")
 	}
 }
+
+func (s *CommentStorage) GetCommentTree(_ context.Context, p storage.GetCommentTreeParams) ([]model.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	roots := s.rankedComments(s.roots[p.PostID], p.PostID, p.Sort)
+	out := slices.Clone(roots[:min(len(roots), p.MaxChildren+1)])
+	level := roots[:min(len(roots), p.MaxChildren)]
+
+	// обход в ширину по byParent: уровень за уровнем, пока не кончится глубина или лимит узлов
+	for depth := 1; depth < p.MaxDepth && len(level) > 0 && len(out) < p.MaxNodes; depth++ {
+		var next []model.Comment
+		for _, c := range level {
+			budget := p.MaxNodes - len(out) - len(next)
+			if budget <= 0 {
+				break
+			}
+			children := s.rankedComments(s.byParent[c.ID], p.PostID, p.Sort)
+			next = append(next, children[:min(len(children), p.MaxChildren, budget)]...)
+		}
+		out = append(out, next...)
+		level = next
+	}
+	return out[:min(len(out), p.MaxNodes)], nil
+}
+
+func (s *CommentStorage) UpdateComment(_ context.Context, commentID int64, body string) (model.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.get(commentID)
+	if !ok || c.IsDeleted() {
+		return model.Comment{}, service.ErrNotFound
+	}
+	now := time.Now()
+	c.Body = body
+	c.EditedAt = &now
+	s.comments[commentID] = c
+	return c, nil
+}
+
+// DeleteComment мягко удаляет комментарий
+func (s *CommentStorage) DeleteComment(_ context.Context, commentID int64) (model.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.get(commentID)
+	if !ok || c.IsDeleted() {
+		return model.Comment{}, service.ErrNotFound
+	}
+	now := time.Now()
+	c.Body = ""
+	c.DeletedAt = &now
+	s.comments[commentID] = c
+	return c, nil
+}
+
+func (s *CommentStorage) addVoteCounts(commentID int64, up, down int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.get(commentID)
+	if !ok {
+		return service.ErrNotFound
+	}
+	c.Upvotes += up
+	c.Downvotes += down
+	rankComment(&c)
+	s.comments[commentID] = c
+	return nil
+}
+
+// get возвращает комментарий по id, вызывать под мьютексом.
+func (s *CommentStorage) get(commentID int64) (model.Comment, bool) {
+	if commentID <= 0 || int(commentID) >= len(s.comments) {
+		return model.Comment{}, false
+	}
+	c := s.comments[commentID]
+	return c, c.ID != 0
+}
+
+// deleteByPost удаляет все комментарии поста (каскад при удалении поста) и возвращает их id.
+func (s *CommentStorage) deleteByPost(postID int64) []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := s.byPost[postID]
+	for _, id := range ids {
+		s.comments[id] = model.Comment{}
+		delete(s.byParent, id)
+	}
+	delete(s.byPost, postID)
+	delete(s.roots, postID)
+	return ids
+}
+
+// postIndex возвращает id комментариев поста в порядке создания
+func (s *CommentStorage) postIndex(postID int64, feed storage.CommentFeed) []int64 {
+	if feed.IncludeReplies {
+		return s.byPost[postID]
+	}
+	return s.roots[postID]
+}
+
+// rankedComments возвращает комментарии поста из ids в порядке sort, вызывать под мьютексом.
+func (s *CommentStorage) rankedComments(ids []int64, postID int64, sort model.CommentSort) []model.Comment {
+	out := make([]model.Comment, 0, len(ids))
+	for _, id := range ids {
+		if c := s.comments[id]; c.PostID == postID {
+			out = append(out, c)
+		}
+	}
+	slices.SortFunc(out, func(a, b model.Comment) int {
+		return compareCommentCursors(sort, service.CommentCursor(sort, a), service.CommentCursor(sort, b))
+	})
+	return out
+}
+
+func (s *CommentStorage) rankedCommentsWithCursor(ids []int64, postID int64, sort model.CommentSort, cursor pagination.Cursor, dir storage.Direction, limit int) ([]model.Comment, error) {
+	return keysetPage(s.rankedComments(ids, postID, sort), cursor, dir, limit, func(c model.Comment, cur pagination.Cursor) int {
+		return compareCommentCursors(sort, service.CommentCursor(sort, c), cur)
+	})
+}
+
+// compareCommentCursors сравнивает позиции в выдаче: <0 — a выдается раньше b.
+func compareCommentCursors(sort model.CommentSort, a, b pagination.Cursor) int {
+	if sort == model.CommentSortOld {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	}
+
+	var c int
+	switch sort {
+	case model.CommentSortBest, model.CommentSortControversial:
+		c = cmp.Compare(b.Rank, a.Rank)
+	case model.CommentSortTop:
+		c = cmp.Compare(b.Score, a.Score)
+	default:
+		c = b.CreatedAt.Compare(a.CreatedAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return cmp.Compare(b.ID, a.ID)
+}
+
+// rankComment пересчитывает ранги комментария, в postgres это generated-колонки.
+func rankComment(c *model.Comment) {
+	c.BestRank = ranking.Wilson(c.Upvotes, c.Downvotes)
+	c.Controversy = ranking.Controversy(c.Upvotes, c.Downvotes)
+}
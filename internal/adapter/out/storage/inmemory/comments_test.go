@@ -37,6 +37,8 @@ func TestCommentStorage_CreateAndGetByID(t *testing.T) {
 
 	got1, err := st.GetCommentByID(context.Background(), 1)
 	require.NoError(t, err)
+	// ответ увеличил счетчик родителя
+	root.ReplyCount = 1
 	require.Equal(t, root, got1)
 
 	got2, err := st.GetCommentByID(context.Background(), 2)
@@ -67,15 +69,57 @@ func TestCommentStorage_GetCommentsByPost_OrderDESC_and_Limit(t *testing.T) {
 		PostID: 20, UserID: 1, Text: "x",
 	})
 
-	got, err := st.GetCommentsByPost(context.Background(), 10, 3)
+	got, err := st.GetCommentsByPost(context.Background(), 10, storage.CommentFeed{}, 3)
 	require.NoError(t, err)
 	require.Equal(t, []int64{5, 4, 3}, collectCommentIDs(got))
 
-	gotNil, err := st.GetCommentsByPost(context.Background(), 30, 10)
+	gotNil, err := st.GetCommentsByPost(context.Background(), 30, storage.CommentFeed{}, 10)
 	require.NoError(t, err)
 	require.Nil(t, gotNil)
 }
 
+func TestCommentStorage_GetCommentsByPost_RootsOnly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := NewCommentStorage()
+
+	root, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "root"})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: &root.ID, UserID: 1, Text: "r"})
+		require.NoError(t, err)
+	}
+	_, err = st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "root"})
+	require.NoError(t, err)
+
+	roots, err := st.GetCommentsByPost(ctx, 10, storage.CommentFeed{}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 1}, collectCommentIDs(roots))
+	require.Equal(t, int64(2), roots[1].ReplyCount)
+	require.Zero(t, roots[0].ReplyCount)
+
+	after, err := st.GetCommentsByPostWithCursor(ctx, storage.GetCommentsParams{
+		PostID:    10,
+		Cursor:    pagination.Cursor{ID: 4},
+		Limit:     10,
+		Direction: storage.DirectionAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, collectCommentIDs(after))
+
+	all, err := st.GetCommentsByPost(ctx, 10, storage.CommentFeed{IncludeReplies: true}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3, 2, 1}, collectCommentIDs(all))
+
+	n, err := st.CountComments(ctx, 10, storage.CommentFeed{})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	n, err = st.CountComments(ctx, 10, storage.CommentFeed{IncludeReplies: true})
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
+
 func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 	t.Parallel()
 
@@ -153,3 +197,236 @@ func collectCommentIDs(in []model.Comment) []int64 {
 	}
 	return out
 }
+
+func TestCommentStorage_UpdateAndSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	st := NewCommentStorage()
+
+	_, err := st.UpdateComment(context.Background(), 1, "x")
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	parent, err := st.CreateComment(context.Background(), service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "parent"})
+	require.NoError(t, err)
+	reply, err := st.CreateComment(context.Background(), service.CreateCommentRequest{PostID: 10, ParentID: &parent.ID, UserID: 2, Text: "reply"})
+	require.NoError(t, err)
+
+	edited, err := st.UpdateComment(context.Background(), parent.ID, "parent v2")
+	require.NoError(t, err)
+	require.Equal(t, "parent v2", edited.Body)
+	require.NotNil(t, edited.EditedAt)
+
+	deleted, err := st.DeleteComment(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.True(t, deleted.IsDeleted())
+	require.Empty(t, deleted.Body)
+
+	_, err = st.DeleteComment(context.Background(), parent.ID)
+	require.ErrorIs(t, err, service.ErrNotFound)
+	_, err = st.UpdateComment(context.Background(), parent.ID, "again")
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	// удаленный комментарий остается в выдаче, ответы на него достижимы
+	got, err := st.GetCommentByID(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.True(t, got.IsDeleted())
+
+	replies, err := st.GetReplies(context.Background(), 10, parent.ID, model.CommentSortNew, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{reply.ID}, collectCommentIDs(replies))
+}
+
+func TestCommentStorage_SortedComments(t *testing.T) {
+	t.Parallel()
+
+	st := NewCommentStorage()
+	ctx := context.Background()
+
+	// id: upvotes/downvotes
+	votes := [][2]int64{
+		{1, 0},  // 1
+		{10, 1}, // 2
+		{5, 5},  // 3
+		{0, 3},  // 4
+		{2, 0},  // 5
+	}
+	for _, v := range votes {
+		c, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "c"})
+		require.NoError(t, err)
+		require.NoError(t, st.addVoteCounts(c.ID, v[0], v[1]))
+	}
+	// ответ не попадает в выдачу другого родителя, но есть в общей ленте поста
+	parentID := int64(2)
+	_, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: &parentID, UserID: 1, Text: "r"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		sort model.CommentSort
+		want []int64
+	}{
+		{sort: model.CommentSortOld, want: []int64{1, 2, 3, 4, 5, 6}},
+		{sort: model.CommentSortTop, want: []int64{2, 5, 1, 6, 3, 4}},
+		{sort: model.CommentSortBest, want: []int64{2, 5, 1, 3, 6, 4}},
+		{sort: model.CommentSortControversial, want: []int64{3, 2, 6, 5, 4, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort.String(), func(t *testing.T) {
+			feed := storage.CommentFeed{Sort: tt.sort, IncludeReplies: true}
+			all, err := st.GetCommentsByPost(ctx, 10, feed, 10)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, collectCommentIDs(all))
+
+			var paged []model.Comment
+			page, err := st.GetCommentsByPost(ctx, 10, feed, 4)
+			require.NoError(t, err)
+			for len(page) > 0 {
+				paged = append(paged, page...)
+				page, err = st.GetCommentsByPostWithCursor(ctx, storage.GetCommentsParams{
+					PostID:    10,
+					Feed:      feed,
+					Cursor:    service.CommentCursor(tt.sort, page[len(page)-1]),
+					Direction: storage.DirectionAfter,
+					Limit:     4,
+				})
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.want, collectCommentIDs(paged))
+
+			before, err := st.GetCommentsByPostWithCursor(ctx, storage.GetCommentsParams{
+				PostID:    10,
+				Feed:      feed,
+				Cursor:    service.CommentCursor(tt.sort, all[3]),
+				Direction: storage.DirectionBefore,
+				Limit:     2,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.want[1:3], collectCommentIDs(before))
+		})
+	}
+
+	replies, err := st.GetReplies(ctx, 10, 2, model.CommentSortOld, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{6}, collectCommentIDs(replies))
+}
+
+func TestCommentStorage_GetCommentTree(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := NewCommentStorage()
+
+	create := func(parentID *int64) int64 {
+		c, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: parentID, UserID: 1, Text: "c"})
+		require.NoError(t, err)
+		return c.ID
+	}
+	// 1 ─ 2 ─ 4 ─ 5
+	//   └ 3
+	// 6, 7 — корневые
+	r1 := create(nil)
+	r2 := create(&r1)
+	create(&r1)
+	r4 := create(&r2)
+	create(&r4)
+	create(nil)
+	create(nil)
+
+	got, err := st.GetCommentTree(ctx, storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortOld, MaxDepth: 3, MaxChildren: 1, MaxNodes: 100,
+	})
+	require.NoError(t, err)
+	// корневых на один больше лимита, раскрывается только первый; 5 глубже MaxDepth
+	require.Equal(t, []int64{1, 6, 2, 4}, collectCommentIDs(got))
+
+	got, err = st.GetCommentTree(ctx, storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortOld, MaxDepth: 5, MaxChildren: 5, MaxNodes: 4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 6, 7, 2}, collectCommentIDs(got))
+}
+
+func TestCommentStorage_GetCommentTree_NodeBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := NewCommentStorage()
+
+	// полное дерево: по 4 ответа на каждый комментарий, 5 уровней
+	level := []*int64{nil}
+	for depth := 0; depth < 5; depth++ {
+		var next []*int64
+		for _, parent := range level {
+			for range 4 {
+				c, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: parent, UserID: 1, Text: "c"})
+				require.NoError(t, err)
+				next = append(next, &c.ID)
+			}
+		}
+		level = next
+	}
+
+	got, err := st.GetCommentTree(ctx, storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortOld, MaxDepth: 5, MaxChildren: 3, MaxNodes: 20,
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 20)
+
+	// 4 корневых (лишний не раскрывается), 9 ответов, а третий уровень обрезан
+	// лимитом узлов по порядку родителей
+	perDepth := make(map[int]int)
+	for _, c := range got {
+		perDepth[c.Depth]++
+	}
+	require.Equal(t, map[int]int{0: 4, 1: 9, 2: 7}, perDepth)
+	require.Equal(t, got[4].ID, *got[13].ParentID)
+	require.Equal(t, got[6].ID, *got[19].ParentID)
+}
+
+func TestCommentStorage_PathAndDepth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := NewCommentStorage()
+
+	root, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "root"})
+	require.NoError(t, err)
+	require.Zero(t, root.Depth)
+	require.Empty(t, root.Path)
+
+	reply, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: &root.ID, UserID: 1, Text: "r"})
+	require.NoError(t, err)
+	deep, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: &reply.ID, UserID: 1, Text: "rr"})
+	require.NoError(t, err)
+	require.Equal(t, 2, deep.Depth)
+	require.Equal(t, []int64{root.ID, reply.ID}, deep.Path)
+
+	got, err := st.GetCommentsByIDs(ctx, append(deep.Path, 99))
+	require.NoError(t, err)
+	require.Equal(t, []int64{root.ID, reply.ID}, collectCommentIDs(got))
+}
+
+func TestCommentStorage_CreateComment_ParentChecks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := NewCommentStorage()
+
+	parent, err := st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, UserID: 1, Text: "root"})
+	require.NoError(t, err)
+
+	_, err = st.CreateComment(ctx, service.CreateCommentRequest{PostID: 20, ParentID: &parent.ID, UserID: 1, Text: "r"})
+	require.ErrorIs(t, err, service.ErrParentPostMismatch)
+
+	missing := int64(99)
+	_, err = st.CreateComment(ctx, service.CreateCommentRequest{PostID: 10, ParentID: &missing, UserID: 1, Text: "r"})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	// отклоненные ответы не попали в индексы
+	got, err := st.GetCommentsByPost(ctx, 20, storage.CommentFeed{IncludeReplies: true}, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	root, err := st.GetCommentByID(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Zero(t, root.ReplyCount)
+}
@@ -0,0 +1,28 @@
+package inmemory
+
+import (
+	"errors"
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/pkg/pagination"
+	"slices"
+)
+
+// keysetPage отдает страницу из ranked (уже в порядке выдачи) после или до курсора.
+func keysetPage[T any](ranked []T, cursor pagination.Cursor, dir storage.Direction, limit int, compare func(T, pagination.Cursor) int) ([]T, error) {
+	// ranked[:pos] идут раньше курсора; сам курсор (если элемент еще в выдаче) — ranked[pos]
+	pos, found := slices.BinarySearchFunc(ranked, cursor, compare)
+
+	switch dir {
+	case storage.DirectionAfter:
+		if found {
+			pos++
+		}
+		return ranked[pos:min(pos+limit, len(ranked))], nil
+
+	case storage.DirectionBefore:
+		return ranked[max(pos-limit, 0):pos], nil
+
+	default:
+		return nil, errors.New("invalid keyset direction")
+	}
+}
@@ -0,0 +1,73 @@
+package inmemory
+
+import (
+	"context"
+	"myreddit/internal/model"
+	"slices"
+	"sync"
+	"time"
+)
+
+type outboxEntry struct {
+	event       model.OutboxEvent
+	nextAttempt time.Time
+}
+
+// OutboxStorage хранит события в порядке добавления.
+type OutboxStorage struct {
+	mu     sync.Mutex
+	nextID int64
+	events []outboxEntry
+}
+
+func NewOutboxStorage() *OutboxStorage {
+	return &OutboxStorage{}
+}
+
+func (s *OutboxStorage) AddEvent(_ context.Context, e model.OutboxEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	e.ID = s.nextID
+	e.CreatedAt = time.Now()
+	s.events = append(s.events, outboxEntry{event: e, nextAttempt: e.CreatedAt})
+	return nil
+}
+
+func (s *OutboxStorage) FetchDueEvents(_ context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []model.OutboxEvent
+	for _, en := range s.events {
+		if len(out) == limit {
+			break
+		}
+		if !en.nextAttempt.After(now) {
+			out = append(out, en.event)
+		}
+	}
+	return out, nil
+}
+
+func (s *OutboxStorage) DeleteEvent(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = slices.DeleteFunc(s.events, func(en outboxEntry) bool { return en.event.ID == id })
+	return nil
+}
+
+func (s *OutboxStorage) RetryEvent(_ context.Context, id int64, next time.Time, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.events {
+		if s.events[i].event.ID == id {
+			s.events[i].event.Attempts++
+			s.events[i].nextAttempt = next
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"context"
+	"myreddit/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxStorage(t *testing.T) {
+	ctx := context.Background()
+	s := NewOutboxStorage()
+
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, s.AddEvent(ctx, model.OutboxEvent{Topic: model.OutboxTopicCommentAdded, PostID: 1, CommentID: i}))
+	}
+
+	now := time.Now()
+	due, err := s.FetchDueEvents(ctx, now, 2)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, []int64{1, 2}, []int64{due[0].CommentID, due[1].CommentID})
+
+	// отложенное событие не выдается до наступления времени повтора
+	require.NoError(t, s.DeleteEvent(ctx, due[0].ID))
+	require.NoError(t, s.RetryEvent(ctx, due[1].ID, now.Add(time.Minute), "boom"))
+
+	due, err = s.FetchDueEvents(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, int64(3), due[0].CommentID)
+
+	due, err = s.FetchDueEvents(ctx, now.Add(2*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, 1, due[0].Attempts)
+}
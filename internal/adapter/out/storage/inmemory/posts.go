@@ -1,11 +1,14 @@
 package inmemory
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"myreddit/internal/adapter/out/storage"
 	"myreddit/internal/model"
 	"myreddit/internal/service"
+	"myreddit/pkg/pagination"
+	"myreddit/pkg/ranking"
 	"slices"
 	"sync"
 	"time"
@@ -15,6 +18,11 @@ type PostStorage struct {
 	mu    sync.RWMutex
 	posts []model.Post
 	byID  map[int64]model.Post
+
+	// comments — хранилище комментариев для каскадного удаления
+	comments *CommentStorage
+	// votes — хранилище голосов, чистится при удалении поста
+	votes *VoteStorage
 }
 
 func NewPostStorage() *PostStorage {
@@ -24,6 +32,20 @@ func NewPostStorage() *PostStorage {
 	}
 }
 
+// AttachComments включает каскадное удаление комментариев поста.
+func (s *PostStorage) AttachComments(comments *CommentStorage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.comments = comments
+}
+
+// AttachVotes включает удаление голосов вместе с постом.
+func (s *PostStorage) AttachVotes(votes *VoteStorage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.votes = votes
+}
+
 func (s *PostStorage) CreatePost(_ context.Context, in model.Post) (model.Post, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -32,6 +54,7 @@ func (s *PostStorage) CreatePost(_ context.Context, in model.Post) (model.Post,
 	if in.CreatedAt.IsZero() {
 		in.CreatedAt = time.Now()
 	}
+	rank(&in)
 	s.posts = append(s.posts, in)
 	s.byID[in.ID] = in
 	return in, nil
@@ -47,6 +70,19 @@ func (s *PostStorage) GetPostByID(_ context.Context, postID int64) (model.Post,
 	return model.Post{}, service.ErrNotFound
 }
 
+func (s *PostStorage) GetPostsByIDs(_ context.Context, ids []int64) ([]model.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []model.Post
+	for _, id := range ids {
+		if post, ok := s.byID[id]; ok {
+			out = append(out, post)
+		}
+	}
+	return out, nil
+}
+
 func (s *PostStorage) SetCommentsEnabled(_ context.Context, postID int64, enabled bool) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -61,10 +97,15 @@ func (s *PostStorage) SetCommentsEnabled(_ context.Context, postID int64, enable
 	return nil
 }
 
-func (s *PostStorage) GetPosts(_ context.Context, limit int) ([]model.Post, error) {
+func (s *PostStorage) GetPosts(_ context.Context, feed storage.PostFeed, limit int) ([]model.Post, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if !isChronological(feed) {
+		ranked := s.rankedPosts(feed)
+		return ranked[:min(limit, len(ranked))], nil
+	}
+
 	n := len(s.posts) - 1
 	if n <= 0 {
 		return nil, nil
@@ -89,6 +130,10 @@ func (s *PostStorage) GetPostsWithCursor(_ context.Context, params storage.GetPo
 		limit = service.DefaultPostsLimit
 	}
 
+	if !isChronological(params.Feed) {
+		return s.rankedPostsWithCursor(params, limit)
+	}
+
 	out := make([]model.Post, 0, limit)
 
 	switch params.Direction {
@@ -116,6 +161,22 @@ func (s *PostStorage) GetPostsWithCursor(_ context.Context, params storage.GetPo
 	}
 }
 
+func (s *PostStorage) CountPosts(_ context.Context, feed storage.PostFeed) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if feed.Since.IsZero() {
+		return len(s.byID), nil
+	}
+	n := 0
+	for _, p := range s.byID {
+		if !p.CreatedAt.Before(feed.Since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
 func (s *PostStorage) GetPostAuthorID(_ context.Context, postID int64) (int64, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -126,3 +187,111 @@ func (s *PostStorage) GetPostAuthorID(_ context.Context, postID int64) (int64, e
 	}
 	return p.UserID, nil
 }
+
+func (s *PostStorage) UpdatePost(_ context.Context, params storage.UpdatePostParams) (model.Post, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.byID[params.PostID]
+	if !ok {
+		return model.Post{}, service.ErrNotFound
+	}
+	if params.Title != nil {
+		p.Title = *params.Title
+	}
+	if params.Text != nil {
+		p.Text = *params.Text
+	}
+	now := time.Now()
+	p.EditedAt = &now
+
+	s.byID[p.ID] = p
+	s.posts[p.ID] = p
+	return p, nil
+}
+
+func (s *PostStorage) DeletePost(_ context.Context, postID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.byID[postID]; !ok {
+		return service.ErrNotFound
+	}
+	delete(s.byID, postID)
+	s.posts[postID] = model.Post{}
+
+	var commentIDs []int64
+	if s.comments != nil {
+		commentIDs = s.comments.deleteByPost(postID)
+	}
+	if s.votes != nil {
+		s.votes.deleteTargets(model.VoteTargetPost, postID)
+		s.votes.deleteTargets(model.VoteTargetComment, commentIDs...)
+	}
+	return nil
+}
+
+func (s *PostStorage) addVoteCounts(postID int64, up, down int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.byID[postID]
+	if !ok {
+		return service.ErrNotFound
+	}
+	p.Upvotes += up
+	p.Downvotes += down
+	rank(&p)
+	s.byID[postID] = p
+	s.posts[postID] = p
+	return nil
+}
+
+// isChronological — лента по времени создания без фильтра
+func isChronological(feed storage.PostFeed) bool {
+	return feed.Sort == model.PostSortNew && feed.Since.IsZero()
+}
+
+// rankedPosts возвращает посты ленты в порядке выдачи, вызывать под мьютексом.
+func (s *PostStorage) rankedPosts(feed storage.PostFeed) []model.Post {
+	out := make([]model.Post, 0, len(s.byID))
+	for _, p := range s.byID {
+		if feed.Since.IsZero() || !p.CreatedAt.Before(feed.Since) {
+			out = append(out, p)
+		}
+	}
+	slices.SortFunc(out, func(a, b model.Post) int {
+		return comparePostCursors(feed.Sort, service.PostCursor(feed.Sort, a), service.PostCursor(feed.Sort, b))
+	})
+	return out
+}
+
+func (s *PostStorage) rankedPostsWithCursor(params storage.GetPostsParams, limit int) ([]model.Post, error) {
+	sort := params.Feed.Sort
+	return keysetPage(s.rankedPosts(params.Feed), params.Cursor, params.Direction, limit, func(p model.Post, c pagination.Cursor) int {
+		return comparePostCursors(sort, service.PostCursor(sort, p), c)
+	})
+}
+
+// comparePostCursors сравнивает позиции в ленте: <0 — a выдается раньше b.
+func comparePostCursors(sort model.PostSort, a, b pagination.Cursor) int {
+	var c int
+	switch sort {
+	case model.PostSortHot, model.PostSortControversial:
+		c = cmp.Compare(b.Rank, a.Rank)
+	case model.PostSortTop:
+		c = cmp.Compare(b.Score, a.Score)
+	default:
+		c = b.CreatedAt.Compare(a.CreatedAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return cmp.Compare(b.ID, a.ID)
+}
+
+// rank пересчитывает ранги поста, в postgres это generated-колонки.
+func rank(p *model.Post) {
+	p.HotRank = ranking.Hot(p.Upvotes, p.Downvotes, p.CreatedAt)
+	p.Controversy = ranking.Controversy(p.Upvotes, p.Downvotes)
+}
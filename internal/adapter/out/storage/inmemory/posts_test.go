@@ -63,6 +63,40 @@ func TestPostStorage_GetPostByID_NotFound(t *testing.T) {
 	require.ErrorIs(t, err, service.ErrNotFound)
 }
 
+func TestPostStorage_GetPostsByIDs(t *testing.T) {
+	t.Parallel()
+
+	st := NewPostStorage()
+	ctx := context.Background()
+	p1, err := st.CreatePost(ctx, model.Post{UserID: 1, Title: "t1", Text: "b1"})
+	require.NoError(t, err)
+	p2, err := st.CreatePost(ctx, model.Post{UserID: 1, Title: "t2", Text: "b2"})
+	require.NoError(t, err)
+
+	got, err := st.GetPostsByIDs(ctx, []int64{p2.ID, 99, p1.ID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []model.Post{p1, p2}, got)
+}
+
+func TestPostStorage_CountPosts(t *testing.T) {
+	t.Parallel()
+
+	st := NewPostStorage()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		_, err := st.CreatePost(ctx, model.Post{UserID: 1, Title: "t", Text: "b"})
+		require.NoError(t, err)
+	}
+
+	n, err := st.CountPosts(ctx, storage.PostFeed{})
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	n, err = st.CountPosts(ctx, storage.PostFeed{Since: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
 func TestPostStorage_SetCommentsEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -99,7 +133,7 @@ func TestPostStorage_GetPosts_OrderDESC_and_Limit(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	got, err := st.GetPosts(context.Background(), 3)
+	got, err := st.GetPosts(context.Background(), storage.PostFeed{}, 3)
 	require.NoError(t, err)
 	require.Len(t, got, 3)
 	require.Equal(t, int64(5), got[0].ID)
@@ -107,7 +141,7 @@ func TestPostStorage_GetPosts_OrderDESC_and_Limit(t *testing.T) {
 	require.Equal(t, int64(3), got[2].ID)
 
 	st2 := NewPostStorage()
-	list, err := st2.GetPosts(context.Background(), 10)
+	list, err := st2.GetPosts(context.Background(), storage.PostFeed{}, 10)
 	require.NoError(t, err)
 	require.Nil(t, list)
 }
@@ -184,3 +218,137 @@ func collectIDs(posts []model.Post) []int64 {
 	}
 	return out
 }
+
+func TestPostStorage_UpdatePost(t *testing.T) {
+	t.Parallel()
+
+	st := NewPostStorage()
+
+	title := "new title"
+	_, err := st.UpdatePost(context.Background(), storage.UpdatePostParams{PostID: 1, Title: &title})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	p, err := st.CreatePost(context.Background(), model.Post{UserID: 7, Title: "old", Text: "body"})
+	require.NoError(t, err)
+	require.Nil(t, p.EditedAt)
+
+	got, err := st.UpdatePost(context.Background(), storage.UpdatePostParams{PostID: p.ID, Title: &title})
+	require.NoError(t, err)
+	require.Equal(t, "new title", got.Title)
+	require.Equal(t, "body", got.Text)
+	require.NotNil(t, got.EditedAt)
+
+	stored, err := st.GetPostByID(context.Background(), p.ID)
+	require.NoError(t, err)
+	require.Equal(t, got, stored)
+}
+
+func TestPostStorage_DeletePost_CascadesComments(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostStorage()
+	comments := NewCommentStorage()
+	posts.AttachComments(comments)
+
+	require.ErrorIs(t, posts.DeletePost(context.Background(), 1), service.ErrNotFound)
+
+	p1, err := posts.CreatePost(context.Background(), model.Post{UserID: 1, Title: "a", Text: "a"})
+	require.NoError(t, err)
+	p2, err := posts.CreatePost(context.Background(), model.Post{UserID: 1, Title: "b", Text: "b"})
+	require.NoError(t, err)
+
+	root, err := comments.CreateComment(context.Background(), service.CreateCommentRequest{PostID: p1.ID, UserID: 2, Text: "root"})
+	require.NoError(t, err)
+	reply, err := comments.CreateComment(context.Background(), service.CreateCommentRequest{PostID: p1.ID, ParentID: &root.ID, UserID: 3, Text: "reply"})
+	require.NoError(t, err)
+	other, err := comments.CreateComment(context.Background(), service.CreateCommentRequest{PostID: p2.ID, UserID: 2, Text: "other"})
+	require.NoError(t, err)
+
+	require.NoError(t, posts.DeletePost(context.Background(), p1.ID))
+
+	_, err = posts.GetPostByID(context.Background(), p1.ID)
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	list, err := posts.GetPosts(context.Background(), storage.PostFeed{}, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, p2.ID, list[0].ID)
+
+	for _, id := range []int64{root.ID, reply.ID} {
+		_, err = comments.GetCommentByID(context.Background(), id)
+		require.ErrorIs(t, err, service.ErrNotFound)
+	}
+	_, err = comments.GetCommentByID(context.Background(), other.ID)
+	require.NoError(t, err)
+}
+
+func TestPostStorage_RankedFeeds(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostStorage()
+	ctx := context.Background()
+	now := time.Now()
+
+	// id: upvotes/downvotes, возраст
+	seed := []struct {
+		up, down int64
+		age      time.Duration
+	}{
+		{up: 10, down: 0, age: 48 * time.Hour}, // 1
+		{up: 3, down: 0, age: time.Hour},       // 2
+		{up: 5, down: 5, age: 2 * time.Hour},   // 3
+		{up: 0, down: 4, age: 3 * time.Hour},   // 4
+		{up: 8, down: 6, age: 10 * 24 * time.Hour},
+	}
+	for _, sd := range seed {
+		p, err := posts.CreatePost(ctx, model.Post{UserID: 1, Title: "t", Text: "b", CreatedAt: now.Add(-sd.age)})
+		require.NoError(t, err)
+		require.NoError(t, posts.addVoteCounts(p.ID, sd.up, sd.down))
+	}
+
+	tests := []struct {
+		name string
+		feed storage.PostFeed
+		want []int64
+	}{
+		{name: "top all", feed: storage.PostFeed{Sort: model.PostSortTop}, want: []int64{1, 2, 5, 3, 4}},
+		{name: "top week", feed: storage.PostFeed{Sort: model.PostSortTop, Since: now.AddDate(0, 0, -7)}, want: []int64{1, 2, 3, 4}},
+		{name: "hot", feed: storage.PostFeed{Sort: model.PostSortHot}, want: []int64{2, 3, 4, 1, 5}},
+		{name: "controversial", feed: storage.PostFeed{Sort: model.PostSortControversial}, want: []int64{3, 5, 4, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all, err := posts.GetPosts(ctx, tt.feed, 10)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, collectIDs(all))
+
+			// постранично по 2 вперед, затем назад от последней страницы
+			var paged []model.Post
+			page, err := posts.GetPosts(ctx, tt.feed, 2)
+			require.NoError(t, err)
+			for len(page) > 0 {
+				paged = append(paged, page...)
+				page, err = posts.GetPostsWithCursor(ctx, storage.GetPostsParams{
+					Feed:      tt.feed,
+					Cursor:    service.PostCursor(tt.feed.Sort, page[len(page)-1]),
+					Direction: storage.DirectionAfter,
+					Limit:     2,
+				})
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.want, collectIDs(paged))
+
+			last := paged[len(paged)-1]
+			before, err := posts.GetPostsWithCursor(ctx, storage.GetPostsParams{
+				Feed:      tt.feed,
+				Cursor:    service.PostCursor(tt.feed.Sort, last),
+				Direction: storage.DirectionBefore,
+				Limit:     2,
+			})
+			require.NoError(t, err)
+			n := len(tt.want)
+			require.Equal(t, tt.want[n-3:n-1], collectIDs(before))
+		})
+	}
+}
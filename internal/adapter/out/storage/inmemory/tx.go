@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+)
+
+// TxManager — менеджер транзакций хранилищ в памяти.
+type TxManager struct{}
+
+type undoLogKey struct{}
+
+type undoLog struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+func (TxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	parent, _ := ctx.Value(undoLogKey{}).(*undoLog)
+	log := &undoLog{}
+
+	if err := fn(context.WithValue(ctx, undoLogKey{}, log)); err != nil {
+		log.rollback()
+		return err
+	}
+	if parent != nil {
+		parent.mu.Lock()
+		parent.fns = append(parent.fns, log.fns...)
+		parent.mu.Unlock()
+	}
+	return nil
+}
+
+func (l *undoLog) rollback() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := len(l.fns) - 1; i >= 0; i-- {
+		l.fns[i]()
+	}
+	l.fns = nil
+}
+
+// onRollback регистрирует отмену изменения в транзакции ctx
+func onRollback(ctx context.Context, undo func()) {
+	if log, ok := ctx.Value(undoLogKey{}).(*undoLog); ok {
+		log.mu.Lock()
+		log.fns = append(log.fns, undo)
+		log.mu.Unlock()
+	}
+}
@@ -0,0 +1,104 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"sync"
+)
+
+type voteKey struct {
+	userID   int64
+	target   model.VoteTarget
+	targetID int64
+}
+
+type VoteStorage struct {
+	mu    sync.Mutex
+	votes map[voteKey]int8
+
+	// posts, comments — хранилища, в которых лежат счетчики голосов
+	posts    *PostStorage
+	comments *CommentStorage
+}
+
+func NewVoteStorage(posts *PostStorage, comments *CommentStorage) *VoteStorage {
+	return &VoteStorage{
+		votes:    make(map[voteKey]int8),
+		posts:    posts,
+		comments: comments,
+	}
+}
+
+// SetVote сохраняет голос
+func (s *VoteStorage) SetVote(ctx context.Context, vote model.Vote) (int8, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := voteKey{userID: vote.UserID, target: vote.TargetType, targetID: vote.TargetID}
+	prev, existed := s.votes[key]
+	s.votes[key] = vote.Value
+
+	onRollback(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if existed {
+			s.votes[key] = prev
+		} else {
+			delete(s.votes, key)
+		}
+	})
+	return prev, nil
+}
+
+func (s *VoteStorage) GetVote(_ context.Context, userID int64, target model.VoteTarget, targetID int64) (int8, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.votes[voteKey{userID: userID, target: target, targetID: targetID}], nil
+}
+
+// GetVotes возвращает ненулевые голоса пользователя за цели targetIDs.
+func (s *VoteStorage) GetVotes(_ context.Context, userID int64, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]model.Vote, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		if v := s.votes[voteKey{userID: userID, target: target, targetID: id}]; v != 0 {
+			out = append(out, model.Vote{UserID: userID, TargetType: target, TargetID: id, Value: v})
+		}
+	}
+	return out, nil
+}
+
+func (s *VoteStorage) AddVoteCounts(_ context.Context, target model.VoteTarget, targetID int64, up, down int64) error {
+	switch target {
+	case model.VoteTargetPost:
+		return s.posts.addVoteCounts(targetID, up, down)
+	case model.VoteTargetComment:
+		return s.comments.addVoteCounts(targetID, up, down)
+	default:
+		return fmt.Errorf("unknown vote target %q: %w", target, service.ErrInvalidRequest)
+	}
+}
+
+// deleteTargets удаляет голоса всех пользователей за цели ids, как триггеры в postgres.
+func (s *VoteStorage) deleteTargets(target model.VoteTarget, ids ...int64) {
+	if len(ids) == 0 {
+		return
+	}
+	deleted := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		deleted[id] = struct{}{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.votes {
+		if _, ok := deleted[key.targetID]; ok && key.target == target {
+			delete(s.votes, key)
+		}
+	}
+}
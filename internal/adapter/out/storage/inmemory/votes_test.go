@@ -0,0 +1,151 @@
+package inmemory
+
+import (
+	"context"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteStorage_SetVote_And_Counts(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostStorage()
+	comments := NewCommentStorage()
+	votes := NewVoteStorage(posts, comments)
+	ctx := context.Background()
+
+	p, err := posts.CreatePost(ctx, model.Post{UserID: 1, Title: "t", Text: "b"})
+	require.NoError(t, err)
+
+	v, err := votes.GetVote(ctx, 7, model.VoteTargetPost, p.ID)
+	require.NoError(t, err)
+	require.Zero(t, v)
+
+	prev, err := votes.SetVote(ctx, model.Vote{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1})
+	require.NoError(t, err)
+	require.Zero(t, prev)
+
+	prev, err = votes.SetVote(ctx, model.Vote{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: -1})
+	require.NoError(t, err)
+	require.Equal(t, int8(1), prev)
+
+	// голос за пост не пересекается с голосом за комментарий с тем же id
+	v, err = votes.GetVote(ctx, 7, model.VoteTargetComment, p.ID)
+	require.NoError(t, err)
+	require.Zero(t, v)
+
+	require.NoError(t, votes.AddVoteCounts(ctx, model.VoteTargetPost, p.ID, 2, 1))
+	got, err := posts.GetPostByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.Score())
+
+	require.ErrorIs(t, votes.AddVoteCounts(ctx, model.VoteTargetPost, 100, 1, 0), service.ErrNotFound)
+	require.ErrorIs(t, votes.AddVoteCounts(ctx, model.VoteTargetComment, 100, 1, 0), service.ErrNotFound)
+}
+
+// Ошибка после SetVote откатывает голос, как откат транзакции в postgres.
+func TestVoteStorage_SetVote_RolledBack(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostStorage()
+	votes := NewVoteStorage(posts, NewCommentStorage())
+	ctx := context.Background()
+
+	p, err := posts.CreatePost(ctx, model.Post{UserID: 1, Title: "t", Text: "b"})
+	require.NoError(t, err)
+	_, err = votes.SetVote(ctx, model.Vote{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1})
+	require.NoError(t, err)
+
+	err = TxManager{}.Do(ctx, func(ctx context.Context) error {
+		// новый голос откатывается целиком, смененный — к прежнему значению
+		if _, err := votes.SetVote(ctx, model.Vote{UserID: 8, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1}); err != nil {
+			return err
+		}
+		if _, err := votes.SetVote(ctx, model.Vote{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: -1}); err != nil {
+			return err
+		}
+		return votes.AddVoteCounts(ctx, model.VoteTargetPost, 100, 1, 0)
+	})
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	got, err := votes.GetVotes(ctx, 7, model.VoteTargetPost, []int64{p.ID})
+	require.NoError(t, err)
+	require.Equal(t, []model.Vote{{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1}}, got)
+	require.Len(t, votes.votes, 1)
+
+	// вложенный Do откатывает только свои изменения
+	err = TxManager{}.Do(ctx, func(ctx context.Context) error {
+		if _, err := votes.SetVote(ctx, model.Vote{UserID: 8, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1}); err != nil {
+			return err
+		}
+		_ = TxManager{}.Do(ctx, func(ctx context.Context) error {
+			_, _ = votes.SetVote(ctx, model.Vote{UserID: 9, TargetType: model.VoteTargetPost, TargetID: p.ID, Value: 1})
+			return service.ErrNotFound
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	v, err := votes.GetVote(ctx, 8, model.VoteTargetPost, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, int8(1), v)
+	v, err = votes.GetVote(ctx, 9, model.VoteTargetPost, p.ID)
+	require.NoError(t, err)
+	require.Zero(t, v)
+}
+
+func TestVoteStorage_DeletedTargets(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostStorage()
+	comments := NewCommentStorage()
+	posts.AttachComments(comments)
+	votes := NewVoteStorage(posts, comments)
+	posts.AttachVotes(votes)
+	ctx := context.Background()
+
+	p1, err := posts.CreatePost(ctx, model.Post{UserID: 1, Title: "a", Text: "a"})
+	require.NoError(t, err)
+	p2, err := posts.CreatePost(ctx, model.Post{UserID: 1, Title: "b", Text: "b"})
+	require.NoError(t, err)
+	c1, err := comments.CreateComment(ctx, service.CreateCommentRequest{PostID: p1.ID, UserID: 2, Text: "c1"})
+	require.NoError(t, err)
+	c2, err := comments.CreateComment(ctx, service.CreateCommentRequest{PostID: p2.ID, UserID: 2, Text: "c2"})
+	require.NoError(t, err)
+	c3, err := comments.CreateComment(ctx, service.CreateCommentRequest{PostID: p2.ID, UserID: 2, Text: "c3"})
+	require.NoError(t, err)
+
+	for _, v := range []model.Vote{
+		{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p1.ID, Value: 1},
+		{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p2.ID, Value: 1},
+		{UserID: 7, TargetType: model.VoteTargetComment, TargetID: c1.ID, Value: 1},
+		{UserID: 7, TargetType: model.VoteTargetComment, TargetID: c2.ID, Value: 1},
+		{UserID: 7, TargetType: model.VoteTargetComment, TargetID: c3.ID, Value: 1},
+	} {
+		_, err := votes.SetVote(ctx, v)
+		require.NoError(t, err)
+	}
+
+	// удаление поста удаляет голоса за него и за его комментарии
+	require.NoError(t, posts.DeletePost(ctx, p1.ID))
+	// мягко удаленный комментарий сохраняет голоса и счетчики
+	require.NoError(t, votes.AddVoteCounts(ctx, model.VoteTargetComment, c2.ID, 1, 0))
+	_, err = comments.DeleteComment(ctx, c2.ID)
+	require.NoError(t, err)
+	tomb, err := comments.GetCommentByID(ctx, c2.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), tomb.Upvotes)
+
+	got, err := votes.GetVotes(ctx, 7, model.VoteTargetPost, []int64{p1.ID, p2.ID})
+	require.NoError(t, err)
+	require.Equal(t, []model.Vote{{UserID: 7, TargetType: model.VoteTargetPost, TargetID: p2.ID, Value: 1}}, got)
+
+	got, err = votes.GetVotes(ctx, 7, model.VoteTargetComment, []int64{c1.ID, c2.ID, c3.ID})
+	require.NoError(t, err)
+	require.Equal(t, []model.Vote{
+		{UserID: 7, TargetType: model.VoteTargetComment, TargetID: c2.ID, Value: 1},
+		{UserID: 7, TargetType: model.VoteTargetComment, TargetID: c3.ID, Value: 1},
+	}, got)
+}
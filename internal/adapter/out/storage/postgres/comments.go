@@ -8,18 +8,63 @@ import (
 	"myreddit/internal/model"
 	"myreddit/internal/service"
 	"slices"
+	"strings"
 
 	"myreddit/pkg/tableinfo"
 
 	sq "github.com/Masterminds/squirrel"
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 const (
 	DefaultCommentsLimit = 50
+
+	foreignKeyViolation = "23503"
+	// ограничения внешних ключей на parent_id
+	parentConstraint         = "comments_parent_id_fkey"
+	parentSamePostConstraint = "comments_parent_same_post_fkey"
 )
 
+var commentColumns = []string{
+	tableinfo.CommentIDColumn,
+	tableinfo.CommentPostIDColumn,
+	tableinfo.CommentParentIDColumn,
+	tableinfo.CommentUserIDColumn,
+	tableinfo.CommentBodyColumn,
+	tableinfo.CommentCreatedAtColumn,
+	tableinfo.CommentEditedAtColumn,
+	tableinfo.CommentDeletedAtColumn,
+	tableinfo.CommentUpvotesColumn,
+	tableinfo.CommentDownvotesColumn,
+	tableinfo.CommentBestRankColumn,
+	tableinfo.CommentControversyColumn,
+	tableinfo.CommentReplyCountColumn,
+	tableinfo.CommentPathColumn,
+	tableinfo.CommentDepthColumn,
+}
+
+func scanComment(row pgx.Row, c *model.Comment) error {
+	return row.Scan(
+		&c.ID,
+		&c.PostID,
+		&c.ParentID,
+		&c.UserID,
+		&c.Body,
+		&c.CreatedAt,
+		&c.EditedAt,
+		&c.DeletedAt,
+		&c.Upvotes,
+		&c.Downvotes,
+		&c.BestRank,
+		&c.Controversy,
+		&c.ReplyCount,
+		&c.Path,
+		&c.Depth,
+	)
+}
+
 type CommentStorage struct {
 	pool   DB
 	getter *trmpgx.CtxGetter
@@ -39,17 +84,16 @@ func (s *CommentStorage) CreateComment(ctx context.Context, req service.CreateCo
 			tableinfo.CommentParentIDColumn,
 			tableinfo.CommentUserIDColumn,
 			tableinfo.CommentBodyColumn,
+			tableinfo.CommentDepthColumn,
+			tableinfo.CommentPathColumn,
 		).
-		Values(req.PostID, req.ParentID, req.UserID, req.Text).
-		Suffix(fmt.Sprintf(
-			"RETURNING %s, %s, %s, %s, %s, %s",
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		)).
+		Values(
+			req.PostID, req.ParentID, req.UserID, req.Text,
+			// путь и глубина наследуются от родителя, у корневого — пустой путь
+			sq.Expr("COALESCE((SELECT "+tableinfo.CommentDepthColumn+" + 1 FROM "+tableinfo.CommentsTableName+" WHERE "+tableinfo.CommentIDColumn+" = ?), 0)", req.ParentID),
+			sq.Expr("COALESCE((SELECT "+tableinfo.CommentPathColumn+" || "+tableinfo.CommentIDColumn+" FROM "+tableinfo.CommentsTableName+" WHERE "+tableinfo.CommentIDColumn+" = ?), '{}')", req.ParentID),
+		).
+		Suffix("RETURNING " + strings.Join(commentColumns, ", ")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -57,14 +101,16 @@ func (s *CommentStorage) CreateComment(ctx context.Context, req service.CreateCo
 	}
 
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
-	if err := tr.QueryRow(ctx, query, args...).Scan(
-		&out.ID,
-		&out.PostID,
-		&out.ParentID,
-		&out.UserID,
-		&out.Body,
-		&out.CreatedAt,
-	); err != nil {
+	if err := scanComment(tr.QueryRow(ctx, query, args...), &out); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			switch pgErr.ConstraintName {
+			case parentSamePostConstraint:
+				return out, service.ErrParentPostMismatch
+			case parentConstraint:
+				return out, fmt.Errorf("parent-comment: %w", service.ErrNotFound)
+			}
+		}
 		return out, fmt.Errorf("exec insert comment: %w", err)
 	}
 
@@ -75,14 +121,7 @@ func (s *CommentStorage) GetCommentByID(ctx context.Context, commentID int64) (m
 	var out model.Comment
 
 	query, args, err := sq.
-		Select(
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		).
+		Select(commentColumns...).
 		From(tableinfo.CommentsTableName).
 		Where(sq.Eq{tableinfo.CommentIDColumn: commentID}).
 		PlaceholderFormat(sq.Dollar).
@@ -92,14 +131,7 @@ func (s *CommentStorage) GetCommentByID(ctx context.Context, commentID int64) (m
 	}
 
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
-	if err := tr.QueryRow(ctx, query, args...).Scan(
-		&out.ID,
-		&out.PostID,
-		&out.ParentID,
-		&out.UserID,
-		&out.Body,
-		&out.CreatedAt,
-	); err != nil {
+	if err := scanComment(tr.QueryRow(ctx, query, args...), &out); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return out, service.ErrNotFound
 		}
@@ -109,33 +141,58 @@ func (s *CommentStorage) GetCommentByID(ctx context.Context, commentID int64) (m
 	return out, nil
 }
 
-func (s *CommentStorage) GetCommentsByPost(ctx context.Context, postID int64, limit int) ([]model.Comment, error) {
-	if limit <= 0 {
-		limit = DefaultCommentsLimit
+// GetCommentsByIDs отдает найденные комментарии в произвольном порядке.
+func (s *CommentStorage) GetCommentsByIDs(ctx context.Context, ids []int64) ([]model.Comment, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
 
 	query, args, err := sq.
-		Select(
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		).
+		Select(commentColumns...).
 		From(tableinfo.CommentsTableName).
-		Where(sq.Eq{tableinfo.CommentPostIDColumn: postID}).
-		OrderBy(
-			fmt.Sprintf("%s DESC", tableinfo.CommentCreatedAtColumn),
-			fmt.Sprintf("%s DESC", tableinfo.CommentIDColumn),
-		).
-		Limit(uint64(limit)).
+		Where(sq.Expr(tableinfo.CommentIDColumn+" = ANY(?)", ids)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
 	}
 
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	rows, err := tr.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select comments by ids: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]model.Comment, 0, len(ids))
+	for rows.Next() {
+		var c model.Comment
+		if err := scanComment(rows, &c); err != nil {
+			return nil, fmt.Errorf("scan comment: %w", err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+func (s *CommentStorage) GetCommentsByPost(ctx context.Context, postID int64, feed storage.CommentFeed, limit int) ([]model.Comment, error) {
+	if limit <= 0 {
+		limit = DefaultCommentsLimit
+	}
+
+	qb, err := commentKeyset(feed.Sort).page(commentsByPostQuery(postID, feed), nil, storage.DirectionAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
 	rows, err := tr.Query(ctx, query, args...)
 	if err != nil {
@@ -146,14 +203,7 @@ func (s *CommentStorage) GetCommentsByPost(ctx context.Context, postID int64, li
 	out := make([]model.Comment, 0, limit)
 	for rows.Next() {
 		var c model.Comment
-		if err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.UserID,
-			&c.Body,
-			&c.CreatedAt,
-		); err != nil {
+		if err := scanComment(rows, &c); err != nil {
 			return nil, fmt.Errorf("scan comment: %w", err)
 		}
 		out = append(out, c)
@@ -186,14 +236,7 @@ func (s *CommentStorage) GetCommentsByPostWithCursor(ctx context.Context, params
 	out := make([]model.Comment, 0, params.Limit)
 	for rows.Next() {
 		var c model.Comment
-		if err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.UserID,
-			&c.Body,
-			&c.CreatedAt,
-		); err != nil {
+		if err := scanComment(rows, &c); err != nil {
 			return nil, fmt.Errorf("scan comment: %w", err)
 		}
 		out = append(out, c)
@@ -208,32 +251,37 @@ func (s *CommentStorage) GetCommentsByPostWithCursor(ctx context.Context, params
 	return out, nil
 }
 
-func (s *CommentStorage) GetReplies(ctx context.Context, postID, parentID int64, limit int) ([]model.Comment, error) {
-	if limit <= 0 {
-		limit = DefaultCommentsLimit
-	}
-
+// CountComments считает комментарии поста, попадающие в выдачу feed.
+func (s *CommentStorage) CountComments(ctx context.Context, postID int64, feed storage.CommentFeed) (int, error) {
 	query, args, err := sq.
-		Select(
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		).
+		Select("COUNT(*)").
 		From(tableinfo.CommentsTableName).
-		Where(sq.Eq{
-			tableinfo.CommentPostIDColumn:   postID,
-			tableinfo.CommentParentIDColumn: parentID,
-		}).
-		OrderBy(
-			fmt.Sprintf("%s DESC", tableinfo.CommentCreatedAtColumn),
-			fmt.Sprintf("%s DESC", tableinfo.CommentIDColumn),
-		).
-		Limit(uint64(limit)).
+		Where(commentsByPostFilter(postID, feed)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	var n int
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if err := tr.QueryRow(ctx, query, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("exec count comments: %w", err)
+	}
+	return n, nil
+}
+
+func (s *CommentStorage) GetReplies(ctx context.Context, postID, parentID int64, sort model.CommentSort, limit int) ([]model.Comment, error) {
+	if limit <= 0 {
+		limit = DefaultCommentsLimit
+	}
+
+	qb, err := commentKeyset(sort).page(repliesQuery(postID, parentID), nil, storage.DirectionAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := qb.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
 	}
@@ -248,14 +296,7 @@ func (s *CommentStorage) GetReplies(ctx context.Context, postID, parentID int64,
 	out := make([]model.Comment, 0, limit)
 	for rows.Next() {
 		var c model.Comment
-		if err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.UserID,
-			&c.Body,
-			&c.CreatedAt,
-		); err != nil {
+		if err := scanComment(rows, &c); err != nil {
 			return nil, fmt.Errorf("scan replies: %w", err)
 		}
 		out = append(out, c)
@@ -287,14 +328,7 @@ func (s *CommentStorage) GetRepliesWithCursor(ctx context.Context, params storag
 	out := make([]model.Comment, 0, params.Limit)
 	for rows.Next() {
 		var c model.Comment
-		if err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.UserID,
-			&c.Body,
-			&c.CreatedAt,
-		); err != nil {
+		if err := scanComment(rows, &c); err != nil {
 			return nil, fmt.Errorf("scan replies after/before: %w", err)
 		}
 		out = append(out, c)
@@ -310,57 +344,113 @@ func (s *CommentStorage) GetRepliesWithCursor(ctx context.Context, params storag
 	return out, nil
 }
 
+func (s *CommentStorage) GetCommentTree(ctx context.Context, params storage.GetCommentTreeParams) ([]model.Comment, error) {
+	query, args := getCommentTreeQuery(params)
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	rows, err := tr.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select comment tree: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]model.Comment, 0, min(params.MaxNodes, DefaultCommentsLimit))
+	for rows.Next() {
+		var c model.Comment
+		if err := scanComment(rows, &c); err != nil {
+			return nil, fmt.Errorf("scan comment tree: %w", err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows comment tree: %w", err)
+	}
+	return out, nil
+}
+
+func (s *CommentStorage) UpdateComment(ctx context.Context, commentID int64, body string) (model.Comment, error) {
+	var out model.Comment
+
+	query, args, err := sq.
+		Update(tableinfo.CommentsTableName).
+		Set(tableinfo.CommentBodyColumn, body).
+		Set(tableinfo.CommentEditedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{
+			tableinfo.CommentIDColumn:        commentID,
+			tableinfo.CommentDeletedAtColumn: nil,
+		}).
+		Suffix("RETURNING " + strings.Join(commentColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return out, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if err := scanComment(tr.QueryRow(ctx, query, args...), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return out, service.ErrNotFound
+		}
+		return out, fmt.Errorf("exec update comment: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteComment мягко удаляет комментарий
+func (s *CommentStorage) DeleteComment(ctx context.Context, commentID int64) (model.Comment, error) {
+	var out model.Comment
+
+	query, args, err := sq.
+		Update(tableinfo.CommentsTableName).
+		Set(tableinfo.CommentBodyColumn, "").
+		Set(tableinfo.CommentDeletedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{
+			tableinfo.CommentIDColumn:        commentID,
+			tableinfo.CommentDeletedAtColumn: nil,
+		}).
+		Suffix("RETURNING " + strings.Join(commentColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return out, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if err := scanComment(tr.QueryRow(ctx, query, args...), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return out, service.ErrNotFound
+		}
+		return out, fmt.Errorf("exec soft delete comment: %w", err)
+	}
+	return out, nil
+}
+
 func getCommentsQueryBuilder(params storage.GetCommentsParams) (sq.SelectBuilder, error) {
 	limit := params.Limit
 	if limit <= 0 {
 		limit = DefaultCommentsLimit
 	}
+	return commentKeyset(params.Feed.Sort).page(commentsByPostQuery(params.PostID, params.Feed), &params.Cursor, params.Direction, limit)
+}
 
-	base := sq.
-		Select(
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		).
+// commentsByPostQuery выбирает комментарии поста из выдачи feed без порядка и лимита.
+func commentsByPostQuery(postID int64, feed storage.CommentFeed) sq.SelectBuilder {
+	return sq.
+		Select(commentColumns...).
 		From(tableinfo.CommentsTableName).
-		Where(sq.Eq{tableinfo.CommentPostIDColumn: params.PostID}).
+		Where(commentsByPostFilter(postID, feed)).
 		PlaceholderFormat(sq.Dollar)
+}
 
-	createdAt := tableinfo.CommentCreatedAtColumn
-	idCol := tableinfo.CommentIDColumn
-
-	if params.Direction == storage.DirectionAfter {
-		sb := base.
-			Where(sq.Or{
-				sq.Lt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Lt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" DESC", idCol+" DESC").
-			Limit(uint64(limit))
-		return sb, nil
+// commentsByPostFilter по умолчанию оставляет только корневые комментарии.
+func commentsByPostFilter(postID int64, feed storage.CommentFeed) sq.Eq {
+	if feed.IncludeReplies {
+		return sq.Eq{tableinfo.CommentPostIDColumn: postID}
+	}
+	return sq.Eq{
+		tableinfo.CommentPostIDColumn:   postID,
+		tableinfo.CommentParentIDColumn: nil,
 	}
-
-	if params.Direction == storage.DirectionBefore {
-		sb := base.
-			Where(sq.Or{
-				sq.Gt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Gt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" ASC", idCol+" ASC").
-			Limit(uint64(limit))
-		return sb, nil
-	}
-
-	return sq.SelectBuilder{}, fmt.Errorf("invalid keyset: direction must be set: %w", service.ErrInvalidRequest)
 }
 
 func getRepliesQueryBuilder(params storage.GetRepliesParams) (sq.SelectBuilder, error) {
@@ -368,55 +458,63 @@ func getRepliesQueryBuilder(params storage.GetRepliesParams) (sq.SelectBuilder,
 	if limit <= 0 {
 		limit = DefaultCommentsLimit
 	}
+	return commentKeyset(params.Sort).page(repliesQuery(params.PostID, params.ParentID), &params.Cursor, params.Direction, limit)
+}
 
-	base := sq.
-		Select(
-			tableinfo.CommentIDColumn,
-			tableinfo.CommentPostIDColumn,
-			tableinfo.CommentParentIDColumn,
-			tableinfo.CommentUserIDColumn,
-			tableinfo.CommentBodyColumn,
-			tableinfo.CommentCreatedAtColumn,
-		).
+// repliesQuery выбирает прямые ответы на комментарий parentID без порядка и лимита.
+func repliesQuery(postID, parentID int64) sq.SelectBuilder {
+	return sq.
+		Select(commentColumns...).
 		From(tableinfo.CommentsTableName).
-		Where(sq.And{
-			sq.Eq{tableinfo.CommentPostIDColumn: params.PostID},
-			sq.Eq{tableinfo.CommentParentIDColumn: params.ParentID},
+		Where(sq.Eq{
+			tableinfo.CommentPostIDColumn:   postID,
+			tableinfo.CommentParentIDColumn: parentID,
 		}).
 		PlaceholderFormat(sq.Dollar)
+}
 
-	createdAt := tableinfo.CommentCreatedAtColumn
-	idCol := tableinfo.CommentIDColumn
-
-	if params.Direction == storage.DirectionAfter {
-		// (created_at, id) < (cursor.CreatedAt, cursor.ID)
-		sb := base.
-			Where(sq.Or{
-				sq.Lt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Lt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" DESC", idCol+" DESC").
-			Limit(uint64(limit))
-		return sb, nil
-	}
-
-	if params.Direction == storage.DirectionBefore {
-		// (created_at, id) > (cursor.CreatedAt, cursor.ID)
-		sb := base.
-			Where(sq.Or{
-				sq.Gt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Gt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" ASC", idCol+" ASC").
-			Limit(uint64(limit))
-		return sb, nil
-	}
-
-	return sq.SelectBuilder{}, fmt.Errorf("invalid keyset: direction must be set: %w", service.ErrInvalidRequest)
+// getCommentTreeQuery обходит дерево в ширину рекурсивным CTE.
+func getCommentTreeQuery(p storage.GetCommentTreeParams) (string, []any) {
+	cols := strings.Join(commentColumns, ", ")
+	order := strings.Join(commentKeyset(p.Sort).orderBy(storage.DirectionAfter), ", ")
+
+	child := make([]string, len(commentColumns))
+	for i, col := range commentColumns {
+		child[i] = "ch." + col
+	}
+
+	query := fmt.Sprintf(`WITH RECURSIVE tree AS (
+	SELECT %[1]s, 1 AS tree_depth, rn, 0::BIGINT AS seen, count(*) OVER () AS level_size
+	FROM (
+		SELECT %[1]s, row_number() OVER (ORDER BY %[2]s) AS rn FROM %[3]s
+		WHERE %[4]s = $1 AND %[5]s IS NULL
+		ORDER BY %[2]s
+		LIMIT $2
+	) r
+	UNION ALL
+	SELECT * FROM (
+		SELECT %[6]s, t.tree_depth + 1 AS tree_depth,
+			row_number() OVER (ORDER BY t.rn, ch.child_rn) AS rn,
+			t.seen + t.level_size AS seen,
+			count(*) OVER () AS level_size
+		FROM tree t
+		CROSS JOIN LATERAL (
+			SELECT %[1]s, row_number() OVER (ORDER BY %[2]s) AS child_rn FROM %[3]s
+			WHERE %[5]s = t.%[7]s
+			ORDER BY %[2]s
+			LIMIT $3
+		) ch
+		WHERE t.tree_depth < $4 AND (t.tree_depth > 1 OR t.rn <= $3) AND t.seen + t.level_size < $5
+	) lvl
+	WHERE lvl.seen + lvl.rn <= $5
+)
+SELECT %[1]s FROM tree
+ORDER BY tree_depth, rn`,
+		cols, order, tableinfo.CommentsTableName,
+		tableinfo.CommentPostIDColumn, tableinfo.CommentParentIDColumn,
+		strings.Join(child, ", "), tableinfo.CommentIDColumn,
+	)
+
+	args := []any{p.PostID, p.MaxChildren + 1, p.MaxChildren, p.MaxDepth, p.MaxNodes}
+	return query, args
 }
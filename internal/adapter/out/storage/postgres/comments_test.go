@@ -9,11 +9,15 @@ import (
 	"myreddit/internal/service"
 	"myreddit/pkg/pagination"
 	"myreddit/pkg/tableinfo"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -53,6 +57,38 @@ func Test_getCommentsQueryBuilder(t *testing.T) {
 			wantOrder: "ORDER BY " + tableinfo.CommentCreatedAtColumn + " ASC, " + tableinfo.CommentIDColumn + " ASC",
 			wantOps:   []string{">", tableinfo.CommentCreatedAtColumn, tableinfo.CommentIDColumn},
 		},
+		{
+			name: "old after",
+			params: storage.GetCommentsParams{
+				PostID: 10, Feed: storage.CommentFeed{Sort: model.CommentSortOld}, Cursor: cur, Direction: storage.DirectionAfter, Limit: 5,
+			},
+			wantOrder: "ORDER BY created_at ASC, id ASC",
+			wantOps:   []string{"(created_at, id) > ("},
+		},
+		{
+			name: "old before",
+			params: storage.GetCommentsParams{
+				PostID: 10, Feed: storage.CommentFeed{Sort: model.CommentSortOld}, Cursor: cur, Direction: storage.DirectionBefore, Limit: 5,
+			},
+			wantOrder: "ORDER BY created_at DESC, id DESC",
+			wantOps:   []string{"(created_at, id) < ("},
+		},
+		{
+			name: "best after",
+			params: storage.GetCommentsParams{
+				PostID: 10, Feed: storage.CommentFeed{Sort: model.CommentSortBest}, Cursor: cur, Direction: storage.DirectionAfter, Limit: 5,
+			},
+			wantOrder: "ORDER BY best_rank DESC, id DESC",
+			wantOps:   []string{"(best_rank, id) < ("},
+		},
+		{
+			name: "top before",
+			params: storage.GetCommentsParams{
+				PostID: 10, Feed: storage.CommentFeed{Sort: model.CommentSortTop}, Cursor: cur, Direction: storage.DirectionBefore, Limit: 5,
+			},
+			wantOrder: "ORDER BY score ASC, id ASC",
+			wantOps:   []string{"(score, id) > ("},
+		},
 		{
 			name: "invalid direction",
 			params: storage.GetCommentsParams{
@@ -142,7 +178,7 @@ func TestCommentStorage_CreateComment_Success(t *testing.T) {
 	now := time.Now()
 
 	m.EXPECT().
-		QueryRow(gomock.Any(), gomock.Any(), int64(1), gomock.Nil(), int64(50), "hello").
+		QueryRow(gomock.Any(), gomock.Any(), int64(1), gomock.Nil(), int64(50), "hello", gomock.Nil(), gomock.Nil()).
 		Return(fakeRow{
 			scan: func(dest ...any) error {
 				*(dest[0].(*int64)) = 1001
@@ -171,7 +207,7 @@ func TestCommentStorage_CreateComment_DBError(t *testing.T) {
 
 	m := mocks.NewMockDB(ctrl)
 	m.EXPECT().
-		QueryRow(gomock.Any(), gomock.Any(), int64(1), gomock.Nil(), int64(7), "boom").
+		QueryRow(gomock.Any(), gomock.Any(), int64(1), gomock.Nil(), int64(7), "boom", gomock.Nil(), gomock.Nil()).
 		Return(fakeRow{scan: func(dest ...any) error { return errors.New("insert failed") }})
 
 	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
@@ -256,10 +292,10 @@ func TestCommentStorage_GetCommentsByPost_Success(t *testing.T) {
 
 	now := time.Now()
 	rows := pgxmock.NewRows([]string{
-		"id", "post_id", "parent_id", "user_id", "body", "created_at",
+		"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth",
 	}).
-		AddRow(int64(3), int64(10), nil, int64(7), "c3", now).
-		AddRow(int64(2), int64(10), nil, int64(7), "c2", now.Add(-time.Minute)).
+		AddRow(int64(3), int64(10), nil, int64(7), "c3", now, nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
+		AddRow(int64(2), int64(10), nil, int64(7), "c2", now.Add(-time.Minute), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
 		Kind()
 
 	// у функции есть плейсхолдеры → Query(ctx, sql, args...)
@@ -268,7 +304,7 @@ func TestCommentStorage_GetCommentsByPost_Success(t *testing.T) {
 		Return(rows, nil)
 
 	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
-	got, err := st.GetCommentsByPost(context.Background(), 10, 2)
+	got, err := st.GetCommentsByPost(context.Background(), 10, storage.CommentFeed{}, 2)
 	require.NoError(t, err)
 	require.Len(t, got, 2)
 	require.Equal(t, int64(3), got[0].ID)
@@ -285,7 +321,7 @@ func TestCommentStorage_GetCommentsByPost_QueryError(t *testing.T) {
 		Return(nil, errors.New("boom"))
 
 	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
-	got, err := st.GetCommentsByPost(context.Background(), 10, 5)
+	got, err := st.GetCommentsByPost(context.Background(), 10, storage.CommentFeed{}, 5)
 	require.Error(t, err)
 	require.Nil(t, got)
 	require.Contains(t, err.Error(), "exec select comments")
@@ -297,10 +333,10 @@ func TestCommentStorage_GetCommentsByPost_ScanError(t *testing.T) {
 	m := mocks.NewMockDB(ctrl)
 
 	rows := pgxmock.NewRows([]string{
-		"id", "post_id", "parent_id", "user_id", "body", "created_at",
+		"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth",
 	}).
-		AddRow(int64(1), int64(10), nil, int64(7), "ok", time.Now()).
-		AddRow(int64(2), int64(10), nil, int64(7), "bad", "oops").
+		AddRow(int64(1), int64(10), nil, int64(7), "ok", time.Now(), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
+		AddRow(int64(2), int64(10), nil, int64(7), "bad", "oops", nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
 		Kind()
 
 	m.EXPECT().
@@ -308,7 +344,7 @@ func TestCommentStorage_GetCommentsByPost_ScanError(t *testing.T) {
 		Return(rows, nil)
 
 	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
-	got, err := st.GetCommentsByPost(context.Background(), 10, 5)
+	got, err := st.GetCommentsByPost(context.Background(), 10, storage.CommentFeed{}, 5)
 	require.Error(t, err)
 	require.Nil(t, got)
 	require.Contains(t, err.Error(), "scan comment")
@@ -330,9 +366,9 @@ func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 				Cursor: pagination.Cursor{ID: 5, CreatedAt: now},
 			},
 			setupMock: func(m *mocks.MockDB) {
-				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "user_id", "body", "created_at"}).
-					AddRow(int64(9), int64(10), nil, int64(1), "a", now).
-					AddRow(int64(8), int64(10), nil, int64(1), "b", now.Add(-time.Minute)).
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth"}).
+					AddRow(int64(9), int64(10), nil, int64(1), "a", now, nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
+					AddRow(int64(8), int64(10), nil, int64(1), "b", now.Add(-time.Minute), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
 					Kind()
 
 				m.EXPECT().
@@ -341,7 +377,6 @@ func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 						gomock.Any(),
 						int64(10),
 						now,
-						now,
 						int64(5),
 					).
 					Return(rows, nil)
@@ -355,9 +390,9 @@ func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 				Cursor: pagination.Cursor{ID: 5, CreatedAt: now},
 			},
 			setupMock: func(m *mocks.MockDB) {
-				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "user_id", "body", "created_at"}).
-					AddRow(int64(6), int64(10), nil, int64(2), "x", now).
-					AddRow(int64(7), int64(10), nil, int64(2), "y", now.Add(time.Second)).
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth"}).
+					AddRow(int64(6), int64(10), nil, int64(2), "x", now, nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
+					AddRow(int64(7), int64(10), nil, int64(2), "y", now.Add(time.Second), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0).
 					Kind()
 
 				m.EXPECT().
@@ -366,7 +401,6 @@ func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 						gomock.Any(),
 						int64(10),
 						now,
-						now,
 						int64(5),
 					).
 					Return(rows, nil)
@@ -395,3 +429,299 @@ func TestCommentStorage_GetCommentsByPostWithCursor_After_Before(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentStorage_DeleteComment(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		scan  func(dest ...any) error
+		check func(t *testing.T, c model.Comment, err error)
+	}{
+		{
+			name: "success",
+			scan: func(dest ...any) error {
+				*(dest[0].(*int64)) = 5
+				*(dest[1].(*int64)) = 10
+				*(dest[2].(**int64)) = nil
+				*(dest[3].(*int64)) = 7
+				*(dest[4].(*string)) = ""
+				*(dest[5].(*time.Time)) = now
+				*(dest[7].(**time.Time)) = &now
+				return nil
+			},
+			check: func(t *testing.T, c model.Comment, err error) {
+				require.NoError(t, err)
+				require.True(t, c.IsDeleted())
+				require.Empty(t, c.Body)
+			},
+		},
+		{
+			name: "not found or already deleted",
+			scan: func(dest ...any) error { return pgx.ErrNoRows },
+			check: func(t *testing.T, _ model.Comment, err error) {
+				require.ErrorIs(t, err, service.ErrNotFound)
+			},
+		},
+		{
+			name: "db error",
+			scan: func(dest ...any) error { return errors.New("db down") },
+			check: func(t *testing.T, _ model.Comment, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "exec soft delete comment")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockDB(ctrl)
+			// порядок аргументов: ctx, sql, body, commentID
+			m.EXPECT().
+				QueryRow(gomock.Any(), gomock.Any(), "", int64(5)).
+				Return(fakeRow{scan: tt.scan})
+
+			st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+			got, err := st.DeleteComment(context.Background(), 5)
+			tt.check(t, got, err)
+		})
+	}
+}
+
+func Test_getCommentTreeQuery(t *testing.T) {
+	sql, args := getCommentTreeQuery(storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortBest, MaxDepth: 3, MaxChildren: 5, MaxNodes: 100,
+	})
+
+	require.Contains(t, sql, "WITH RECURSIVE tree")
+	require.Contains(t, sql, "parent_id IS NULL")
+	require.Contains(t, sql, "CROSS JOIN LATERAL")
+	require.Contains(t, sql, "row_number() OVER (ORDER BY best_rank DESC, id DESC) AS child_rn")
+	require.Contains(t, sql, "row_number() OVER (ORDER BY t.rn, ch.child_rn) AS rn")
+	require.Contains(t, sql, "ORDER BY tree_depth, rn")
+	require.Equal(t, []any{int64(10), 6, 5, 3, 100}, args)
+}
+
+// Лимит узлов проверяется в рекурсивной части: уровень, исчерпавший лимит,
+// не раскрывается, а узлы сверх лимита не попадают в рабочую таблицу.
+func Test_getCommentTreeQuery_NodeBudget(t *testing.T) {
+	sql, _ := getCommentTreeQuery(storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortOld, MaxDepth: 8, MaxChildren: 50, MaxNodes: 500,
+	})
+
+	recursive := sql[strings.Index(sql, "UNION ALL"):strings.Index(sql, "SELECT "+strings.Join(commentColumns, ", ")+" FROM tree")]
+	require.Contains(t, recursive, "t.seen + t.level_size < $5")
+	require.Contains(t, recursive, "WHERE lvl.seen + lvl.rn <= $5")
+	require.NotContains(t, sql[strings.LastIndex(sql, "FROM tree"):], "LIMIT")
+}
+
+// Колонки CTE не должны повторяться: иначе ссылки на них в рекурсии и внешнем
+// запросе неоднозначны и postgres отклоняет запрос.
+func Test_getCommentTreeQuery_Columns(t *testing.T) {
+	sql, _ := getCommentTreeQuery(storage.GetCommentTreeParams{
+		PostID: 10, Sort: model.CommentSortOld, MaxDepth: 3, MaxChildren: 5, MaxNodes: 100,
+	})
+	cols := strings.Join(commentColumns, ", ")
+
+	anchor := regexp.MustCompile(`tree AS \(\s*SELECT ([^\n]+?)\s+FROM`).FindStringSubmatch(sql)
+	require.Len(t, anchor, 2)
+	require.Equal(t, cols+", 1 AS tree_depth, rn, 0::BIGINT AS seen, count(*) OVER () AS level_size", strings.Join(strings.Fields(anchor[1]), " "))
+
+	seen := make(map[string]bool)
+	for _, item := range splitSelectList(anchor[1]) {
+		f := strings.Fields(item)
+		name := f[len(f)-1]
+		require.False(t, seen[name], "duplicate CTE column %s", name)
+		seen[name] = true
+	}
+
+	// рекурсивная часть отдает те же колонки под теми же именами
+	recursive := regexp.MustCompile(`SELECT \* FROM \(\s*SELECT ([\s\S]+?)\s+FROM tree t`).FindStringSubmatch(sql)
+	require.Len(t, recursive, 2)
+	items := splitSelectList(recursive[1])
+	require.Len(t, items, len(seen))
+	for _, name := range []string{"tree_depth", "rn", "seen", "level_size"} {
+		require.True(t, slices.ContainsFunc(items, func(item string) bool {
+			return strings.HasSuffix(strings.TrimSpace(item), " AS "+name)
+		}), "recursive term has no %s", name)
+	}
+
+	require.Contains(t, sql, "SELECT "+cols+" FROM tree\nORDER BY tree_depth, rn")
+	require.Contains(t, sql, "t.tree_depth + 1")
+	require.Contains(t, sql, "WHERE t.tree_depth < $4")
+}
+
+// splitSelectList делит список выражений SELECT по запятым верхнего уровня.
+func splitSelectList(list string) []string {
+	var (
+		out   []string
+		depth int
+		start int
+	)
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(out, list[start:])
+}
+
+func TestCommentStorage_GetCommentTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	now := time.Now()
+	parent := int64(1)
+	rows := pgxmock.NewRows([]string{
+		"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth",
+	}).
+		AddRow(int64(1), int64(10), nil, int64(7), "root", now, nil, nil, int64(0), int64(0), float64(0), float64(0), int64(1), []int64{}, 0).
+		AddRow(int64(2), int64(10), &parent, int64(7), "reply", now, nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{parent}, 1).
+		Kind()
+
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), int64(10), 4, 3, 2, 50).
+		Return(rows, nil)
+
+	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+	got, err := st.GetCommentTree(context.Background(), storage.GetCommentTreeParams{
+		PostID: 10, MaxDepth: 2, MaxChildren: 3, MaxNodes: 50,
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, int64(1), got[0].ReplyCount)
+	require.Equal(t, parent, *got[1].ParentID)
+}
+
+func TestCommentStorage_GetCommentTree_QueryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("boom"))
+
+	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+	_, err := st.GetCommentTree(context.Background(), storage.GetCommentTreeParams{PostID: 10, MaxDepth: 1, MaxChildren: 1, MaxNodes: 1})
+	require.ErrorContains(t, err, "exec select comment tree")
+}
+
+func TestCommentStorage_CreateComment_Reply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockDB(ctrl)
+	parent := int64(3)
+
+	// путь и глубина вычисляются из родителя в том же INSERT
+	m.EXPECT().
+		QueryRow(gomock.Any(), gomock.Any(), int64(1), &parent, int64(50), "reply", &parent, &parent).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) pgx.Row {
+			require.Contains(t, sql, "SELECT depth + 1 FROM comments WHERE id = $5")
+			require.Contains(t, sql, "SELECT path || id FROM comments WHERE id = $6")
+			return fakeRow{scan: func(dest ...any) error {
+				*(dest[0].(*int64)) = 4
+				*(dest[13].(*[]int64)) = []int64{1, parent}
+				*(dest[14].(*int)) = 2
+				return nil
+			}}
+		})
+
+	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+	out, err := st.CreateComment(context.Background(), service.CreateCommentRequest{
+		PostID: 1, ParentID: &parent, UserID: 50, Text: "reply",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, parent}, out.Path)
+	require.Equal(t, 2, out.Depth)
+}
+
+func TestCommentStorage_GetCommentsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	rows := pgxmock.NewRows([]string{
+		"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth",
+	}).
+		AddRow(int64(1), int64(10), nil, int64(7), "root", time.Now(), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(1), []int64{}, 0).
+		Kind()
+
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), []int64{1, 2}).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+			require.Contains(t, sql, "id = ANY($1)")
+			return rows, nil
+		})
+
+	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+	got, err := st.GetCommentsByIDs(context.Background(), []int64{1, 2})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	got, err = st.GetCommentsByIDs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestCommentStorage_CreateComment_ParentForeignKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		wantErr    error
+	}{
+		{name: "parent from another post", constraint: "comments_parent_same_post_fkey", wantErr: service.ErrParentPostMismatch},
+		{name: "parent missing", constraint: "comments_parent_id_fkey", wantErr: service.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockDB(ctrl)
+			m.EXPECT().
+				QueryRow(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(fakeRow{scan: func(dest ...any) error {
+					return &pgconn.PgError{Code: "23503", ConstraintName: tt.constraint}
+				}})
+
+			parent := int64(3)
+			st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+			_, err := st.CreateComment(context.Background(), service.CreateCommentRequest{
+				PostID: 1, ParentID: &parent, UserID: 7, Text: "r",
+			})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommentStorage_CountComments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	m.EXPECT().
+		QueryRow(gomock.Any(), "SELECT COUNT(*) FROM comments WHERE parent_id IS NULL AND post_id = $1", int64(10)).
+		Return(fakeRow{scan: func(dest ...any) error {
+			*dest[0].(*int) = 3
+			return nil
+		}})
+
+	st := NewCommentStorage(m, trmpgx.DefaultCtxGetter)
+	n, err := st.CountComments(context.Background(), 10, storage.CommentFeed{})
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"fmt"
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/internal/model"
+	"myreddit/pkg/pagination"
+	"myreddit/pkg/tableinfo"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// keyColumn — колонка составного ключа сортировки выдачи
+type keyColumn struct {
+	name string
+	// asc — выдача по возрастанию колонки
+	asc bool
+	// value — значение колонки в курсоре
+	value func(pagination.Cursor) any
+}
+
+// keyset — составной ключ сортировки выдачи, последняя колонка уникальна (обычно id).
+type keyset []keyColumn
+
+// orderBy — порядок чтения в направлении dir: before читает выдачу в обратном порядке.
+func (k keyset) orderBy(dir storage.Direction) []string {
+	out := make([]string, 0, len(k))
+	for _, c := range k {
+		if c.asc != (dir == storage.DirectionBefore) {
+			out = append(out, c.name+" ASC")
+		} else {
+			out = append(out, c.name+" DESC")
+		}
+	}
+	return out
+}
+
+// seek — условие "строго после курсора в порядке чтения".
+func (k keyset) seek(cursor pagination.Cursor, dir storage.Direction) sq.Sqlizer {
+	if k.uniform() {
+		names := make([]string, len(k))
+		values := make([]any, len(k))
+		for i, c := range k {
+			names[i], values[i] = c.name, c.value(cursor)
+		}
+		op := "<"
+		if k[0].asc != (dir == storage.DirectionBefore) {
+			op = ">"
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(k)), ", ")
+		return sq.Expr("("+strings.Join(names, ", ")+") "+op+" ("+placeholders+")", values...)
+	}
+
+	or := make(sq.Or, 0, len(k))
+	for i, c := range k {
+		and := make(sq.And, 0, i+1)
+		for _, prev := range k[:i] {
+			and = append(and, sq.Eq{prev.name: prev.value(cursor)})
+		}
+		if c.asc != (dir == storage.DirectionBefore) {
+			and = append(and, sq.Gt{c.name: c.value(cursor)})
+		} else {
+			and = append(and, sq.Lt{c.name: c.value(cursor)})
+		}
+
+		if len(and) == 1 {
+			or = append(or, and[0])
+		} else {
+			or = append(or, and)
+		}
+	}
+	return or
+}
+
+// uniform — все колонки ключа отсортированы в одну сторону.
+func (k keyset) uniform() bool {
+	for _, c := range k[1:] {
+		if c.asc != k[0].asc {
+			return false
+		}
+	}
+	return true
+}
+
+// page добавляет к base условие по курсору, порядок и лимит.
+func (k keyset) page(base sq.SelectBuilder, cursor *pagination.Cursor, dir storage.Direction, limit int) (sq.SelectBuilder, error) {
+	if dir != storage.DirectionAfter && dir != storage.DirectionBefore {
+		return sq.SelectBuilder{}, fmt.Errorf("invalid keyset: %w", storage.ErrDirectionUnset)
+	}
+	if cursor != nil {
+		base = base.Where(k.seek(*cursor, dir))
+	}
+	return base.OrderBy(k.orderBy(dir)...).Limit(uint64(limit)), nil
+}
+
+func cursorCreatedAt(c pagination.Cursor) any { return c.CreatedAt }
+func cursorID(c pagination.Cursor) any        { return c.ID }
+func cursorScore(c pagination.Cursor) any     { return c.Score }
+func cursorRank(c pagination.Cursor) any      { return c.Rank }
+
+// postKeyset — ключ ленты постов: колонка порядка sort и id, по убыванию.
+func postKeyset(sort model.PostSort) keyset {
+	key := keyColumn{name: tableinfo.PostCreatedAtColumn, value: cursorCreatedAt}
+	switch sort {
+	case model.PostSortHot:
+		key = keyColumn{name: tableinfo.PostHotRankColumn, value: cursorRank}
+	case model.PostSortTop:
+		key = keyColumn{name: tableinfo.PostScoreColumn, value: cursorScore}
+	case model.PostSortControversial:
+		key = keyColumn{name: tableinfo.PostControversyColumn, value: cursorRank}
+	}
+	return keyset{key, {name: tableinfo.PostIDColumn, value: cursorID}}
+}
+
+// commentKeyset — ключ комментариев
+func commentKeyset(sort model.CommentSort) keyset {
+	key := keyColumn{name: tableinfo.CommentCreatedAtColumn, value: cursorCreatedAt}
+	switch sort {
+	case model.CommentSortOld:
+		key.asc = true
+	case model.CommentSortTop:
+		key = keyColumn{name: tableinfo.CommentScoreColumn, value: cursorScore}
+	case model.CommentSortBest:
+		key = keyColumn{name: tableinfo.CommentBestRankColumn, value: cursorRank}
+	case model.CommentSortControversial:
+		key = keyColumn{name: tableinfo.CommentControversyColumn, value: cursorRank}
+	}
+	return keyset{key, {name: tableinfo.CommentIDColumn, asc: key.asc, value: cursorID}}
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/internal/model"
+	"myreddit/pkg/pagination"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_keyset_page(t *testing.T) {
+	ks := keyset{
+		{name: "a", value: cursorScore},
+		{name: "b", asc: true, value: cursorCreatedAt},
+		{name: "id", value: cursorID},
+	}
+	at := time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC)
+	cur := &pagination.Cursor{Score: 7, CreatedAt: at, ID: 3}
+	base := sq.Select("*").From("t").PlaceholderFormat(sq.Dollar)
+
+	tests := []struct {
+		name     string
+		cursor   *pagination.Cursor
+		dir      storage.Direction
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "start",
+			dir:      storage.DirectionAfter,
+			wantSQL:  "SELECT * FROM t ORDER BY a DESC, b ASC, id DESC LIMIT 5",
+			wantArgs: nil,
+		},
+		{
+			name:     "after",
+			cursor:   cur,
+			dir:      storage.DirectionAfter,
+			wantSQL:  "SELECT * FROM t WHERE (a < $1 OR (a = $2 AND b > $3) OR (a = $4 AND b = $5 AND id < $6)) ORDER BY a DESC, b ASC, id DESC LIMIT 5",
+			wantArgs: []any{int64(7), int64(7), at, int64(7), at, int64(3)},
+		},
+		{
+			name:     "before",
+			cursor:   cur,
+			dir:      storage.DirectionBefore,
+			wantSQL:  "SELECT * FROM t WHERE (a > $1 OR (a = $2 AND b < $3) OR (a = $4 AND b = $5 AND id > $6)) ORDER BY a ASC, b DESC, id ASC LIMIT 5",
+			wantArgs: []any{int64(7), int64(7), at, int64(7), at, int64(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qb, err := ks.page(base, tt.cursor, tt.dir, 5)
+			require.NoError(t, err)
+
+			sql, args, err := qb.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+
+	_, err := ks.page(base, cur, storage.DirectionUnspecified, 5)
+	require.ErrorIs(t, err, storage.ErrDirectionUnset)
+}
+
+// Ключи лент отсортированы в одну сторону: условие курсора — сравнение строк,
+// чтобы postgres взял его границей диапазона составного индекса.
+func Test_keyset_page_RowComparison(t *testing.T) {
+	at := time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC)
+	cur := &pagination.Cursor{Score: 7, CreatedAt: at, ID: 3}
+	base := sq.Select("*").From("t").PlaceholderFormat(sq.Dollar)
+
+	tests := []struct {
+		name     string
+		ks       keyset
+		dir      storage.Direction
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "posts new after",
+			ks:       postKeyset(model.PostSortNew),
+			dir:      storage.DirectionAfter,
+			wantSQL:  "SELECT * FROM t WHERE (created_at, id) < ($1, $2) ORDER BY created_at DESC, id DESC LIMIT 5",
+			wantArgs: []any{at, int64(3)},
+		},
+		{
+			name:     "posts top before",
+			ks:       postKeyset(model.PostSortTop),
+			dir:      storage.DirectionBefore,
+			wantSQL:  "SELECT * FROM t WHERE (score, id) > ($1, $2) ORDER BY score ASC, id ASC LIMIT 5",
+			wantArgs: []any{int64(7), int64(3)},
+		},
+		{
+			name:     "comments old after",
+			ks:       commentKeyset(model.CommentSortOld),
+			dir:      storage.DirectionAfter,
+			wantSQL:  "SELECT * FROM t WHERE (created_at, id) > ($1, $2) ORDER BY created_at ASC, id ASC LIMIT 5",
+			wantArgs: []any{at, int64(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qb, err := tt.ks.page(base, cur, tt.dir, 5)
+			require.NoError(t, err)
+
+			sql, args, err := qb.ToSql()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
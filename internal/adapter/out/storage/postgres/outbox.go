@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"myreddit/internal/model"
+	"myreddit/pkg/tableinfo"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+)
+
+type OutboxStorage struct {
+	pool   DB
+	getter *trmpgx.CtxGetter
+}
+
+func NewOutboxStorage(pool DB, getter *trmpgx.CtxGetter) *OutboxStorage {
+	return &OutboxStorage{pool: pool, getter: getter}
+}
+
+func (s *OutboxStorage) AddEvent(ctx context.Context, e model.OutboxEvent) error {
+	query, args, err := sq.
+		Insert(tableinfo.OutboxTableName).
+		Columns(
+			tableinfo.OutboxTopicColumn,
+			tableinfo.OutboxPostIDColumn,
+			tableinfo.OutboxCommentIDColumn,
+		).
+		Values(string(e.Topic), e.PostID, nullableID(e.CommentID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if _, err := tr.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec insert outbox event: %w", err)
+	}
+	return nil
+}
+
+// FetchDueEvents блокирует выбранные строки (FOR UPDATE SKIP LOCKED) до конца транзакции
+func (s *OutboxStorage) FetchDueEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	query, args, err := sq.
+		Select(
+			tableinfo.OutboxIDColumn,
+			tableinfo.OutboxTopicColumn,
+			tableinfo.OutboxPostIDColumn,
+			"COALESCE("+tableinfo.OutboxCommentIDColumn+", 0)",
+			tableinfo.OutboxAttemptsColumn,
+			tableinfo.OutboxCreatedAtColumn,
+		).
+		From(tableinfo.OutboxTableName).
+		Where(sq.LtOrEq{tableinfo.OutboxNextAttemptAtColumn: now}).
+		OrderBy(tableinfo.OutboxIDColumn).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	rows, err := tr.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]model.OutboxEvent, 0, limit)
+	for rows.Next() {
+		var (
+			e     model.OutboxEvent
+			topic string
+		)
+		if err := rows.Scan(&e.ID, &topic, &e.PostID, &e.CommentID, &e.Attempts, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan outbox event: %w", err)
+		}
+		e.Topic = model.OutboxTopic(topic)
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+func (s *OutboxStorage) DeleteEvent(ctx context.Context, id int64) error {
+	query, args, err := sq.
+		Delete(tableinfo.OutboxTableName).
+		Where(sq.Eq{tableinfo.OutboxIDColumn: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if _, err := tr.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec delete outbox event: %w", err)
+	}
+	return nil
+}
+
+func (s *OutboxStorage) RetryEvent(ctx context.Context, id int64, next time.Time, lastErr string) error {
+	query, args, err := sq.
+		Update(tableinfo.OutboxTableName).
+		Set(tableinfo.OutboxAttemptsColumn, sq.Expr(tableinfo.OutboxAttemptsColumn+" + 1")).
+		Set(tableinfo.OutboxNextAttemptAtColumn, next).
+		Set(tableinfo.OutboxLastErrorColumn, lastErr).
+		Where(sq.Eq{tableinfo.OutboxIDColumn: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if _, err := tr.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec update outbox event: %w", err)
+	}
+	return nil
+}
+
+// nullableID — NULL вместо нулевого id
+func nullableID(id int64) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
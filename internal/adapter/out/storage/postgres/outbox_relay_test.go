@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"myreddit/internal/service"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newRelay собирает релей на хранилищах postgres и менеджерах транзакций, как в приложении
+func newRelay(t *testing.T, pool pgxmock.PgxPoolIface, bus service.EventBus) *service.OutboxRelay {
+	t.Helper()
+	txManager := manager.Must(trmpgx.NewDefaultFactory(pool))
+	savepoints := manager.Must(trmpgx.NewDefaultFactory(pool), manager.WithSettings(
+		trmpgx.MustSettings(settings.Must(settings.WithPropagation(trm.PropagationNested))),
+	))
+	return service.NewOutboxRelay(
+		NewOutboxStorage(pool, trmpgx.DefaultCtxGetter),
+		NewPostStorage(pool, trmpgx.DefaultCtxGetter),
+		NewCommentStorage(pool, trmpgx.DefaultCtxGetter),
+		bus, txManager, savepoints, service.OutboxConfig{MaxAttempts: 3},
+	)
+}
+
+func expectOutboxEvent(pool pgxmock.PgxPoolIface, attempts int) {
+	pool.ExpectBegin()
+	pool.ExpectQuery("FROM outbox").
+		WithArgs(pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "topic", "post_id", "comment_id", "attempts", "created_at"}).
+			AddRow(int64(7), "comment_added", int64(10), int64(5), attempts, time.Now()))
+}
+
+func commentRows() *pgxmock.Rows {
+	return pgxmock.NewRows([]string{
+		"id", "post_id", "parent_id", "user_id", "body", "created_at", "edited_at", "deleted_at", "upvotes", "downvotes", "best_rank", "controversy", "reply_count", "path", "depth",
+	}).AddRow(int64(5), int64(10), nil, int64(7), "hi", time.Now(), nil, nil, int64(0), int64(0), float64(0), float64(0), int64(0), []int64{}, 0)
+}
+
+// Ошибка доставки откатывает только savepoint события: попытка записывается
+// в той же транзакции, а не теряется вместе с откатом всей выборки.
+func TestOutboxRelay_DeliveryErrorRecordsRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer pool.Close()
+
+	bus := service.NewMockEventBus(ctrl)
+	bus.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("pg_notify failed"))
+
+	expectOutboxEvent(pool, 0)
+	pool.ExpectBegin()
+	pool.ExpectQuery("FROM comments").WithArgs(int64(5)).WillReturnRows(commentRows())
+	pool.ExpectRollback()
+	pool.ExpectExec("UPDATE outbox SET").
+		WithArgs(pgxmock.AnyArg(), "pg_notify failed", int64(7)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	pool.ExpectCommit()
+
+	n, err := newRelay(t, pool, bus).RelayBatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+// Ошибка запроса при перечитывании тоже считается попыткой; последняя попытка
+// отбрасывает событие, и оно не блокирует следующие.
+func TestOutboxRelay_QueryErrorDropsAfterMaxAttempts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer pool.Close()
+
+	expectOutboxEvent(pool, 2)
+	pool.ExpectBegin()
+	pool.ExpectQuery("FROM comments").WithArgs(int64(5)).WillReturnError(errors.New("connection reset"))
+	pool.ExpectRollback()
+	pool.ExpectExec("DELETE FROM outbox").
+		WithArgs(int64(7)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	pool.ExpectCommit()
+
+	n, err := newRelay(t, pool, service.NewMockEventBus(ctrl)).RelayBatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
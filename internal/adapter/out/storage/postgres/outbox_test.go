@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"myreddit/internal/adapter/out/storage/postgres/mocks"
+	"myreddit/internal/model"
+	"strings"
+	"testing"
+	"time"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxStorage_AddEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockDB(ctrl)
+	m.EXPECT().
+		Exec(gomock.Any(), gomock.Any(), "comment_added", int64(10), int64(5)).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+
+	// у событий постов comment_id — NULL
+	m.EXPECT().
+		Exec(gomock.Any(), gomock.Any(), "post_deleted", int64(10), nil).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+
+	st := NewOutboxStorage(m, trmpgx.DefaultCtxGetter)
+	err := st.AddEvent(context.Background(), model.OutboxEvent{Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 5})
+	require.NoError(t, err)
+	err = st.AddEvent(context.Background(), model.OutboxEvent{Topic: model.OutboxTopicPostDeleted, PostID: 10})
+	require.NoError(t, err)
+}
+
+func TestOutboxStorage_FetchDueEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	rows := pgxmock.NewRows([]string{"id", "topic", "post_id", "comment_id", "attempts", "created_at"}).
+		AddRow(int64(1), "comment_added", int64(10), int64(5), 0, now).
+		AddRow(int64(2), "comment_added", int64(10), int64(6), 3, now).
+		Kind()
+
+	m := mocks.NewMockDB(ctrl)
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), now).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+			require.True(t, strings.HasSuffix(sql, "FOR UPDATE SKIP LOCKED"), sql)
+			require.Contains(t, sql, "ORDER BY id LIMIT 100")
+			return rows, nil
+		})
+
+	st := NewOutboxStorage(m, trmpgx.DefaultCtxGetter)
+	out, err := st.FetchDueEvents(context.Background(), now, 100)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Equal(t, model.OutboxTopicCommentAdded, out[0].Topic)
+	require.Equal(t, 3, out[1].Attempts)
+}
+
+func TestOutboxStorage_RetryEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := time.Now().Add(time.Minute)
+	m := mocks.NewMockDB(ctrl)
+	m.EXPECT().
+		Exec(gomock.Any(), gomock.Any(), next, "bus down", int64(7)).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+			require.Contains(t, sql, "attempts = attempts + 1")
+			return pgconn.NewCommandTag("UPDATE 1"), nil
+		})
+	m.EXPECT().
+		Exec(gomock.Any(), gomock.Any(), int64(7)).
+		Return(pgconn.CommandTag{}, errors.New("db fail"))
+
+	st := NewOutboxStorage(m, trmpgx.DefaultCtxGetter)
+	require.NoError(t, st.RetryEvent(context.Background(), 7, next, "bus down"))
+	require.Error(t, st.DeleteEvent(context.Background(), 7))
+}
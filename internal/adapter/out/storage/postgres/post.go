@@ -9,6 +9,7 @@ import (
 	"myreddit/internal/service"
 	"myreddit/pkg/tableinfo"
 	"slices"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
@@ -33,6 +34,36 @@ type DB interface {
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 }
 
+var postColumns = []string{
+	tableinfo.PostIDColumn,
+	tableinfo.PostTitleColumn,
+	tableinfo.PostBodyColumn,
+	tableinfo.PostUserIDColumn,
+	tableinfo.PostCommentsEnabledColumn,
+	tableinfo.PostCreatedAtColumn,
+	tableinfo.PostEditedAtColumn,
+	tableinfo.PostUpvotesColumn,
+	tableinfo.PostDownvotesColumn,
+	tableinfo.PostHotRankColumn,
+	tableinfo.PostControversyColumn,
+}
+
+func scanPost(row pgx.Row, p *model.Post) error {
+	return row.Scan(
+		&p.ID,
+		&p.Title,
+		&p.Text,
+		&p.UserID,
+		&p.CommentsEnabled,
+		&p.CreatedAt,
+		&p.EditedAt,
+		&p.Upvotes,
+		&p.Downvotes,
+		&p.HotRank,
+		&p.Controversy,
+	)
+}
+
 type PostStorage struct {
 	// pool   *pgxpool.Pool
 	pool   DB
@@ -58,14 +89,7 @@ func (s *PostStorage) CreatePost(ctx context.Context, post model.Post) (model.Po
 			tableinfo.PostCommentsEnabledColumn,
 		).
 		Values(post.Title, post.Text, post.UserID, post.CommentsEnabled).
-		Suffix(fmt.Sprintf("RETURNING %s, %s, %s, %s, %s, %s",
-			tableinfo.PostIDColumn,
-			tableinfo.PostTitleColumn,
-			tableinfo.PostBodyColumn,
-			tableinfo.PostUserIDColumn,
-			tableinfo.PostCommentsEnabledColumn,
-			tableinfo.PostCreatedAtColumn,
-		)).
+		Suffix("RETURNING " + strings.Join(postColumns, ", ")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -74,14 +98,7 @@ func (s *PostStorage) CreatePost(ctx context.Context, post model.Post) (model.Po
 	}
 
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
-	if err := tr.QueryRow(ctx, query, args...).Scan(
-		&out.ID,
-		&out.Title,
-		&out.Text,
-		&out.UserID,
-		&out.CommentsEnabled,
-		&out.CreatedAt,
-	); err != nil {
+	if err := scanPost(tr.QueryRow(ctx, query, args...), &out); err != nil {
 		return out, fmt.Errorf("exec error creating post: %w", err)
 	}
 
@@ -92,14 +109,7 @@ func (s *PostStorage) GetPostByID(ctx context.Context, postID int64) (model.Post
 	var out model.Post
 
 	query, args, err := sq.
-		Select(
-			tableinfo.PostIDColumn,
-			tableinfo.PostTitleColumn,
-			tableinfo.PostBodyColumn,
-			tableinfo.PostUserIDColumn,
-			tableinfo.PostCommentsEnabledColumn,
-			tableinfo.PostCreatedAtColumn,
-		).
+		Select(postColumns...).
 		From(tableinfo.PostsTableName).
 		Where(sq.Eq{tableinfo.PostIDColumn: postID}).
 		PlaceholderFormat(sq.Dollar).
@@ -109,14 +119,7 @@ func (s *PostStorage) GetPostByID(ctx context.Context, postID int64) (model.Post
 	}
 
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
-	if err := tr.QueryRow(ctx, query, args...).Scan(
-		&out.ID,
-		&out.Title,
-		&out.Text,
-		&out.UserID,
-		&out.CommentsEnabled,
-		&out.CreatedAt,
-	); err != nil {
+	if err := scanPost(tr.QueryRow(ctx, query, args...), &out); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return out, service.ErrNotFound
 		}
@@ -126,25 +129,16 @@ func (s *PostStorage) GetPostByID(ctx context.Context, postID int64) (model.Post
 	return out, nil
 }
 
-func (s *PostStorage) GetPosts(ctx context.Context, limit int) ([]model.Post, error) {
-	if limit <= 0 {
-		limit = service.DefaultPostsLimit
+// GetPostsByIDs отдает найденные посты в произвольном порядке.
+func (s *PostStorage) GetPostsByIDs(ctx context.Context, ids []int64) ([]model.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
+
 	query, args, err := sq.
-		Select(
-			tableinfo.PostIDColumn,
-			tableinfo.PostTitleColumn,
-			tableinfo.PostBodyColumn,
-			tableinfo.PostUserIDColumn,
-			tableinfo.PostCommentsEnabledColumn,
-			tableinfo.PostCreatedAtColumn,
-		).
+		Select(postColumns...).
 		From(tableinfo.PostsTableName).
-		OrderBy(
-			fmt.Sprintf("%s DESC", tableinfo.PostCreatedAtColumn),
-			fmt.Sprintf("%s DESC", tableinfo.PostIDColumn),
-		).
-		Limit(uint64(limit)).
+		Where(sq.Expr(tableinfo.PostIDColumn+" = ANY(?)", ids)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -152,7 +146,41 @@ func (s *PostStorage) GetPosts(ctx context.Context, limit int) ([]model.Post, er
 	}
 
 	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
-	// tr := s.queryer(ctx)
+	rows, err := tr.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select posts by ids: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]model.Post, 0, len(ids))
+	for rows.Next() {
+		var p model.Post
+		if err := scanPost(rows, &p); err != nil {
+			return nil, fmt.Errorf("scan post: %w", err)
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+func (s *PostStorage) GetPosts(ctx context.Context, feed storage.PostFeed, limit int) ([]model.Post, error) {
+	if limit <= 0 {
+		limit = service.DefaultPostsLimit
+	}
+	qb, err := postKeyset(feed.Sort).page(postsFeedQuery(feed), nil, storage.DirectionAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
 
 	rows, err := tr.Query(ctx, query, args...)
 	if err != nil {
@@ -163,14 +191,7 @@ func (s *PostStorage) GetPosts(ctx context.Context, limit int) ([]model.Post, er
 	out := make([]model.Post, 0, limit)
 	for rows.Next() {
 		var p model.Post
-		if err := rows.Scan(
-			&p.ID,
-			&p.Title,
-			&p.Text,
-			&p.UserID,
-			&p.CommentsEnabled,
-			&p.CreatedAt,
-		); err != nil {
+		if err := scanPost(rows, &p); err != nil {
 			return nil, fmt.Errorf("scan error: %w", err)
 		}
 		out = append(out, p)
@@ -208,14 +229,7 @@ func (s *PostStorage) GetPostsWithCursor(ctx context.Context, params storage.Get
 	out := make([]model.Post, 0, limit)
 	for rows.Next() {
 		var p model.Post
-		if err := rows.Scan(
-			&p.ID,
-			&p.Title,
-			&p.Text,
-			&p.UserID,
-			&p.CommentsEnabled,
-			&p.CreatedAt,
-		); err != nil {
+		if err := scanPost(rows, &p); err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
 		out = append(out, p)
@@ -230,6 +244,29 @@ func (s *PostStorage) GetPostsWithCursor(ctx context.Context, params storage.Get
 	return out, nil
 }
 
+// CountPosts считает посты ленты feed (учитывается только Since).
+func (s *PostStorage) CountPosts(ctx context.Context, feed storage.PostFeed) (int, error) {
+	qb := sq.
+		Select("COUNT(*)").
+		From(tableinfo.PostsTableName).
+		PlaceholderFormat(sq.Dollar)
+	if !feed.Since.IsZero() {
+		qb = qb.Where(sq.GtOrEq{tableinfo.PostCreatedAtColumn: feed.Since})
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	var n int
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if err := tr.QueryRow(ctx, query, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("exec count posts: %w", err)
+	}
+	return n, nil
+}
+
 func (s *PostStorage) GetPostAuthorID(ctx context.Context, postID int64) (int64, error) {
 	query, args, err := sq.
 		Select(tableinfo.PostUserIDColumn).
@@ -277,56 +314,77 @@ func (s *PostStorage) SetCommentsEnabled(ctx context.Context, postID int64, enab
 	return nil
 }
 
+func (s *PostStorage) UpdatePost(ctx context.Context, params storage.UpdatePostParams) (model.Post, error) {
+	var out model.Post
+
+	qb := sq.
+		Update(tableinfo.PostsTableName).
+		Set(tableinfo.PostEditedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{tableinfo.PostIDColumn: params.PostID}).
+		Suffix("RETURNING " + strings.Join(postColumns, ", ")).
+		PlaceholderFormat(sq.Dollar)
+	if params.Title != nil {
+		qb = qb.Set(tableinfo.PostTitleColumn, *params.Title)
+	}
+	if params.Text != nil {
+		qb = qb.Set(tableinfo.PostBodyColumn, *params.Text)
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return out, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if err := scanPost(tr.QueryRow(ctx, query, args...), &out); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return out, service.ErrNotFound
+		}
+		return out, fmt.Errorf("exec update post: %w", err)
+	}
+	return out, nil
+}
+
+// DeletePost удаляет пост, комментарии удаляются каскадно (ON DELETE CASCADE).
+func (s *PostStorage) DeletePost(ctx context.Context, postID int64) error {
+	query, args, err := sq.
+		Delete(tableinfo.PostsTableName).
+		Where(sq.Eq{tableinfo.PostIDColumn: postID}).
+		Suffix(fmt.Sprintf("RETURNING %s", tableinfo.PostIDColumn)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	var dummy int64
+	if err := tr.QueryRow(ctx, query, args...).Scan(&dummy); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrNotFound
+		}
+		return fmt.Errorf("exec delete post: %w", err)
+	}
+	return nil
+}
+
 func getPostsQueryBuilder(params storage.GetPostsParams) (sq.SelectBuilder, error) {
 	limit := params.Limit
 	if limit <= 0 {
 		limit = service.DefaultPostsLimit
 	}
+	return postKeyset(params.Feed.Sort).page(postsFeedQuery(params.Feed), &params.Cursor, params.Direction, limit)
+}
 
-	base := sq.
-		Select(
-			tableinfo.PostIDColumn,
-			tableinfo.PostTitleColumn,
-			tableinfo.PostBodyColumn,
-			tableinfo.PostUserIDColumn,
-			tableinfo.PostCommentsEnabledColumn,
-			tableinfo.PostCreatedAtColumn,
-		).
+// postsFeedQuery выбирает посты ленты feed без порядка и лимита.
+func postsFeedQuery(feed storage.PostFeed) sq.SelectBuilder {
+	qb := sq.
+		Select(postColumns...).
 		From(tableinfo.PostsTableName).
 		PlaceholderFormat(sq.Dollar)
-
-	createdAt := tableinfo.PostCreatedAtColumn
-	idCol := tableinfo.PostIDColumn
-
-	if params.Direction == storage.DirectionAfter {
-		// (created_at, id) < (cursor.CreatedAt, cursor.ID)
-		sb := base.
-			Where(sq.Or{
-				sq.Lt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Lt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" DESC", idCol+" DESC").
-			Limit(uint64(limit))
-		return sb, nil
+	if !feed.Since.IsZero() {
+		qb = qb.Where(sq.GtOrEq{tableinfo.PostCreatedAtColumn: feed.Since})
 	}
-
-	if params.Direction == storage.DirectionBefore {
-		// (created_at, id)> (cursor.CreatedAt, cursor.ID)
-		sb := base.
-			Where(sq.Or{
-				sq.Gt{createdAt: params.Cursor.CreatedAt},
-				sq.And{
-					sq.Eq{createdAt: params.Cursor.CreatedAt},
-					sq.Gt{idCol: params.Cursor.ID},
-				},
-			}).
-			OrderBy(createdAt+" ASC", idCol+" ASC").
-			Limit(uint64(limit))
-		return sb, nil
-	}
-
-	return sq.SelectBuilder{}, fmt.Errorf("invalid keyset: direction must be set")
+	return qb
 }
@@ -52,6 +52,39 @@ func Test_getPostsQueryBuilder(t *testing.T) {
 			wantOrder: "ORDER BY created_at ASC, id ASC",
 			wantWhere: []string{">", "created_at", "id"},
 		},
+		{
+			name: "hot after cursor",
+			params: storage.GetPostsParams{
+				Feed:      storage.PostFeed{Sort: model.PostSortHot},
+				Cursor:    cursor,
+				Direction: storage.DirectionAfter,
+				Limit:     10,
+			},
+			wantOrder: "ORDER BY hot_rank DESC, id DESC",
+			wantWhere: []string{"(hot_rank, id) < ($1, $2)"},
+		},
+		{
+			name: "top within window before cursor",
+			params: storage.GetPostsParams{
+				Feed:      storage.PostFeed{Sort: model.PostSortTop, Since: cursor.CreatedAt.AddDate(0, 0, -7)},
+				Cursor:    cursor,
+				Direction: storage.DirectionBefore,
+				Limit:     10,
+			},
+			wantOrder: "ORDER BY score ASC, id ASC",
+			wantWhere: []string{"created_at >= $1", "(score, id) > ($2, $3)"},
+		},
+		{
+			name: "controversial after cursor",
+			params: storage.GetPostsParams{
+				Feed:      storage.PostFeed{Sort: model.PostSortControversial},
+				Cursor:    cursor,
+				Direction: storage.DirectionAfter,
+				Limit:     10,
+			},
+			wantOrder: "ORDER BY controversy DESC, id DESC",
+			wantWhere: []string{"(controversy, id) < ($1, $2)"},
+		},
 		{
 			name: "invalid direction",
 			params: storage.GetPostsParams{
@@ -145,13 +178,13 @@ func TestPostStorage_GetPostsWithCursor(t *testing.T) {
 			},
 			setupMock: func(m *mocks.MockDB) {
 				rows := pgxmock.
-					NewRows([]string{"id", "title", "body", "user_id", "comments_enabled", "created_at"}).
-					AddRow(int64(1), "t1", "b1", int64(50), true, now).
-					AddRow(int64(2), "t2", "b2", int64(4), true, now.Add(-time.Minute)).
+					NewRows([]string{"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy"}).
+					AddRow(int64(1), "t1", "b1", int64(50), true, now, nil, int64(0), int64(0), float64(0), float64(0)).
+					AddRow(int64(2), "t2", "b2", int64(4), true, now.Add(-time.Minute), nil, int64(0), int64(0), float64(0), float64(0)).
 					Kind()
 
 				m.EXPECT().
-					Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(rows, nil)
 			},
 			check: func(t *testing.T, got []model.Post, err error) {
@@ -170,7 +203,7 @@ func TestPostStorage_GetPostsWithCursor(t *testing.T) {
 			},
 			setupMock: func(m *mocks.MockDB) {
 				m.EXPECT().
-					Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("db fail"))
 			},
 			check: func(t *testing.T, got []model.Post, err error) {
@@ -294,14 +327,14 @@ func TestPostStorage_GetPostsWithCursor_Before_Reverses(t *testing.T) {
 	now := time.Now()
 
 	rows := pgxmock.NewRows([]string{
-		"id", "title", "body", "user_id", "comments_enabled", "created_at",
+		"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy",
 	}).
-		AddRow(int64(1), "t1", "b1", int64(50), true, now).
-		AddRow(int64(2), "t2", "b2", int64(50), true, now.Add(time.Second)).
+		AddRow(int64(1), "t1", "b1", int64(50), true, now, nil, int64(0), int64(0), float64(0), float64(0)).
+		AddRow(int64(2), "t2", "b2", int64(50), true, now.Add(time.Second), nil, int64(0), int64(0), float64(0), float64(0)).
 		Kind()
 
 	mockDB.EXPECT().
-		Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(rows, nil)
 
 	st := NewPostStorage(mockDB, trmpgx.DefaultCtxGetter)
@@ -485,11 +518,11 @@ func TestPostStorage_GetPosts(t *testing.T) {
 			limit: 2,
 			setup: func(m *mocks.MockDB) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "user_id", "comments_enabled", "created_at",
+					"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy",
 				}).
 					// имитируем уже DESC порядок
-					AddRow(int64(3), "t3", "b3", int64(7), true, now).
-					AddRow(int64(2), "t2", "b2", int64(7), true, now.Add(-time.Minute)).
+					AddRow(int64(3), "t3", "b3", int64(7), true, now, nil, int64(0), int64(0), float64(0), float64(0)).
+					AddRow(int64(2), "t2", "b2", int64(7), true, now.Add(-time.Minute), nil, int64(0), int64(0), float64(0), float64(0)).
 					Kind() // -> pgx.Rows
 
 				// ВАЖНО: у GetPosts нет плейсхолдеров → Query(ctx, sql) => 2 аргумента
@@ -509,9 +542,9 @@ func TestPostStorage_GetPosts(t *testing.T) {
 			limit: 0,
 			setup: func(m *mocks.MockDB) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "user_id", "comments_enabled", "created_at",
+					"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy",
 				}).
-					AddRow(int64(5), "t5", "b5", int64(7), true, now).
+					AddRow(int64(5), "t5", "b5", int64(7), true, now, nil, int64(0), int64(0), float64(0), float64(0)).
 					Kind()
 
 				m.EXPECT().
@@ -543,11 +576,11 @@ func TestPostStorage_GetPosts(t *testing.T) {
 			limit: 5,
 			setup: func(m *mocks.MockDB) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "user_id", "comments_enabled", "created_at",
+					"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy",
 				}).
-					AddRow(int64(2), "t2", "b2", int64(7), true, now).
+					AddRow(int64(2), "t2", "b2", int64(7), true, now, nil, int64(0), int64(0), float64(0), float64(0)).
 					// испортим тип в created_at у второй строки → Scan упадёт
-					AddRow(int64(1), "t1", "b1", int64(7), true, "bad_time").
+					AddRow(int64(1), "t1", "b1", int64(7), true, "bad_time", nil, int64(0), int64(0), float64(0), float64(0)).
 					Kind()
 
 				m.EXPECT().
@@ -572,7 +605,7 @@ func TestPostStorage_GetPosts(t *testing.T) {
 
 			st := NewPostStorage(mockDB, trmpgx.DefaultCtxGetter)
 
-			got, err := st.GetPosts(context.Background(), tt.limit)
+			got, err := st.GetPosts(context.Background(), storage.PostFeed{}, tt.limit)
 			tt.check(t, got, err)
 		})
 	}
@@ -581,3 +614,170 @@ func TestPostStorage_GetPosts(t *testing.T) {
 type fakeRow struct{ scan func(dest ...any) error }
 
 func (r fakeRow) Scan(dest ...any) error { return r.scan(dest...) }
+
+func TestPostStorage_UpdatePost(t *testing.T) {
+	now := time.Now()
+	title := "fixed"
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks.MockDB)
+		check func(t *testing.T, got model.Post, err error)
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks.MockDB) {
+				// порядок аргументов: ctx, sql, title, postID
+				m.EXPECT().
+					QueryRow(gomock.Any(), gomock.Any(), "fixed", int64(10)).
+					Return(fakeRow{
+						scan: func(dest ...any) error {
+							*(dest[0].(*int64)) = 10
+							*(dest[1].(*string)) = "fixed"
+							*(dest[2].(*string)) = "body"
+							*(dest[3].(*int64)) = 4
+							*(dest[4].(*bool)) = true
+							*(dest[5].(*time.Time)) = now
+							*(dest[6].(**time.Time)) = &now
+							return nil
+						},
+					})
+			},
+			check: func(t *testing.T, got model.Post, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fixed", got.Title)
+				require.NotNil(t, got.EditedAt)
+			},
+		},
+		{
+			name: "not found",
+			setup: func(m *mocks.MockDB) {
+				m.EXPECT().
+					QueryRow(gomock.Any(), gomock.Any(), "fixed", int64(10)).
+					Return(fakeRow{scan: func(dest ...any) error { return pgx.ErrNoRows }})
+			},
+			check: func(t *testing.T, _ model.Post, err error) {
+				require.ErrorIs(t, err, service.ErrNotFound)
+			},
+		},
+		{
+			name: "db error",
+			setup: func(m *mocks.MockDB) {
+				m.EXPECT().
+					QueryRow(gomock.Any(), gomock.Any(), "fixed", int64(10)).
+					Return(fakeRow{scan: func(dest ...any) error { return errors.New("db down") }})
+			},
+			check: func(t *testing.T, _ model.Post, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "exec update post")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockDB(ctrl)
+			tt.setup(m)
+
+			st := NewPostStorage(m, trmpgx.DefaultCtxGetter)
+			got, err := st.UpdatePost(context.Background(), storage.UpdatePostParams{PostID: 10, Title: &title})
+			tt.check(t, got, err)
+		})
+	}
+}
+
+func TestPostStorage_DeletePost(t *testing.T) {
+	tests := []struct {
+		name  string
+		scan  func(dest ...any) error
+		check func(t *testing.T, err error)
+	}{
+		{
+			name: "success",
+			scan: func(dest ...any) error {
+				*(dest[0].(*int64)) = 10
+				return nil
+			},
+			check: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:  "not found",
+			scan:  func(dest ...any) error { return pgx.ErrNoRows },
+			check: func(t *testing.T, err error) { require.ErrorIs(t, err, service.ErrNotFound) },
+		},
+		{
+			name: "db error",
+			scan: func(dest ...any) error { return errors.New("delete failed") },
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "exec delete post")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockDB(ctrl)
+			m.EXPECT().
+				QueryRow(gomock.Any(), gomock.Any(), int64(10)).
+				Return(fakeRow{scan: tt.scan})
+
+			st := NewPostStorage(m, trmpgx.DefaultCtxGetter)
+			tt.check(t, st.DeletePost(context.Background(), 10))
+		})
+	}
+}
+
+func TestPostStorage_GetPostsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	rows := pgxmock.NewRows([]string{
+		"id", "title", "body", "user_id", "comments_enabled", "created_at", "edited_at", "upvotes", "downvotes", "hot_rank", "controversy",
+	}).
+		AddRow(int64(2), "t2", "b2", int64(7), true, time.Now(), nil, int64(0), int64(0), float64(0), float64(0)).
+		Kind()
+
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), []int64{1, 2}).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+			require.Contains(t, sql, "id = ANY($1)")
+			return rows, nil
+		})
+
+	st := NewPostStorage(m, trmpgx.DefaultCtxGetter)
+	got, err := st.GetPostsByIDs(context.Background(), []int64{1, 2})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, int64(2), got[0].ID)
+
+	got, err = st.GetPostsByIDs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestPostStorage_CountPosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	since := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	m.EXPECT().
+		QueryRow(gomock.Any(), "SELECT COUNT(*) FROM posts WHERE created_at >= $1", since).
+		Return(fakeRow{scan: func(dest ...any) error {
+			*dest[0].(*int) = 5
+			return nil
+		}})
+
+	st := NewPostStorage(m, trmpgx.DefaultCtxGetter)
+	n, err := st.CountPosts(context.Background(), storage.PostFeed{Sort: model.PostSortTop, Since: since})
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+}
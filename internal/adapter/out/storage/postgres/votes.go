@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"myreddit/pkg/tableinfo"
+
+	sq "github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+)
+
+type VoteStorage struct {
+	pool   DB
+	getter *trmpgx.CtxGetter
+}
+
+func NewVoteStorage(pool DB, getter *trmpgx.CtxGetter) *VoteStorage {
+	return &VoteStorage{pool: pool, getter: getter}
+}
+
+// SetVote делает upsert голоса.
+func (s *VoteStorage) SetVote(ctx context.Context, vote model.Vote) (int8, error) {
+	insert, args, err := sq.
+		Insert(tableinfo.VotesTableName).
+		Columns(
+			tableinfo.VoteUserIDColumn,
+			tableinfo.VoteTargetTypeColumn,
+			tableinfo.VoteTargetIDColumn,
+			tableinfo.VoteValueColumn,
+		).
+		Values(vote.UserID, string(vote.TargetType), vote.TargetID, vote.Value).
+		Suffix("ON CONFLICT DO NOTHING RETURNING " + tableinfo.VoteValueColumn).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	var inserted int8
+	err = tr.QueryRow(ctx, insert, args...).Scan(&inserted)
+	if err == nil {
+		return 0, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("exec insert vote: %w", err)
+	}
+
+	key := voteKey(vote.UserID, vote.TargetType, vote.TargetID)
+
+	sel, args, err := sq.
+		Select(tableinfo.VoteValueColumn).
+		From(tableinfo.VotesTableName).
+		Where(key).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	var prev int8
+	if err := tr.QueryRow(ctx, sel, args...).Scan(&prev); err != nil {
+		return 0, fmt.Errorf("exec select vote for update: %w", err)
+	}
+	if prev == vote.Value {
+		return prev, nil
+	}
+
+	upd, args, err := sq.
+		Update(tableinfo.VotesTableName).
+		Set(tableinfo.VoteValueColumn, vote.Value).
+		Where(key).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+	if _, err := tr.Exec(ctx, upd, args...); err != nil {
+		return 0, fmt.Errorf("exec update vote: %w", err)
+	}
+	return prev, nil
+}
+
+func (s *VoteStorage) GetVote(ctx context.Context, userID int64, target model.VoteTarget, targetID int64) (int8, error) {
+	query, args, err := sq.
+		Select(tableinfo.VoteValueColumn).
+		From(tableinfo.VotesTableName).
+		Where(voteKey(userID, target, targetID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	var v int8
+	if err := tr.QueryRow(ctx, query, args...).Scan(&v); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("exec select vote: %w", err)
+	}
+	return v, nil
+}
+
+// GetVotes отдает голоса пользователя за цели targetIDs в произвольном порядке
+func (s *VoteStorage) GetVotes(ctx context.Context, userID int64, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.
+		Select(tableinfo.VoteTargetIDColumn, tableinfo.VoteValueColumn).
+		From(tableinfo.VotesTableName).
+		Where(sq.Eq{
+			tableinfo.VoteUserIDColumn:     userID,
+			tableinfo.VoteTargetTypeColumn: string(target),
+		}).
+		Where(sq.Expr(tableinfo.VoteTargetIDColumn+" = ANY(?)", targetIDs)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+	rows, err := tr.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec select votes: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]model.Vote, 0, len(targetIDs))
+	for rows.Next() {
+		v := model.Vote{UserID: userID, TargetType: target}
+		if err := rows.Scan(&v.TargetID, &v.Value); err != nil {
+			return nil, fmt.Errorf("scan vote: %w", err)
+		}
+		out = append(out, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+// AddVoteCounts сдвигает денормализованные счетчики upvotes/downvotes цели.
+func (s *VoteStorage) AddVoteCounts(ctx context.Context, target model.VoteTarget, targetID int64, up, down int64) error {
+	var table, idCol, upCol, downCol string
+	switch target {
+	case model.VoteTargetPost:
+		table, idCol = tableinfo.PostsTableName, tableinfo.PostIDColumn
+		upCol, downCol = tableinfo.PostUpvotesColumn, tableinfo.PostDownvotesColumn
+	case model.VoteTargetComment:
+		table, idCol = tableinfo.CommentsTableName, tableinfo.CommentIDColumn
+		upCol, downCol = tableinfo.CommentUpvotesColumn, tableinfo.CommentDownvotesColumn
+	default:
+		return fmt.Errorf("unknown vote target %q: %w", target, service.ErrInvalidRequest)
+	}
+
+	query, args, err := sq.
+		Update(table).
+		Set(upCol, sq.Expr(upCol+" + ?", up)).
+		Set(downCol, sq.Expr(downCol+" + ?", down)).
+		Where(sq.Eq{idCol: targetID}).
+		Suffix("RETURNING " + idCol).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBuildingQuery, err)
+	}
+
+	tr := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	var dummy int64
+	if err := tr.QueryRow(ctx, query, args...).Scan(&dummy); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrNotFound
+		}
+		return fmt.Errorf("exec update vote counts: %w", err)
+	}
+	return nil
+}
+
+func voteKey(userID int64, target model.VoteTarget, targetID int64) sq.Eq {
+	return sq.Eq{
+		tableinfo.VoteUserIDColumn:     userID,
+		tableinfo.VoteTargetTypeColumn: string(target),
+		tableinfo.VoteTargetIDColumn:   targetID,
+	}
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"myreddit/internal/adapter/out/storage/postgres/mocks"
+	"myreddit/internal/model"
+	"myreddit/internal/service"
+	"testing"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVoteStorage_SetVote(t *testing.T) {
+	vote := model.Vote{UserID: 7, TargetType: model.VoteTargetPost, TargetID: 1, Value: -1}
+
+	scanValue := func(v int8) fakeRow {
+		return fakeRow{scan: func(dest ...any) error {
+			*(dest[0].(*int8)) = v
+			return nil
+		}}
+	}
+	noRows := fakeRow{scan: func(dest ...any) error { return pgx.ErrNoRows }}
+
+	tests := []struct {
+		name     string
+		setup    func(m *mocks.MockDB)
+		wantPrev int8
+		wantErr  string
+	}{
+		{
+			name: "new vote inserted",
+			setup: func(m *mocks.MockDB) {
+				m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), int64(7), "post", int64(1), int8(-1)).
+					Return(scanValue(-1))
+			},
+			wantPrev: 0,
+		},
+		{
+			name: "existing vote updated",
+			setup: func(m *mocks.MockDB) {
+				gomock.InOrder(
+					m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(noRows),
+					m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(scanValue(1)),
+					m.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(pgconn.NewCommandTag("UPDATE 1"), nil),
+				)
+			},
+			wantPrev: 1,
+		},
+		{
+			name: "same value skips update",
+			setup: func(m *mocks.MockDB) {
+				gomock.InOrder(
+					m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(noRows),
+					m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(scanValue(-1)),
+				)
+			},
+			wantPrev: -1,
+		},
+		{
+			name: "insert error",
+			setup: func(m *mocks.MockDB) {
+				m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(fakeRow{scan: func(dest ...any) error { return errors.New("db down") }})
+			},
+			wantErr: "exec insert vote",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockDB(ctrl)
+			tt.setup(m)
+
+			st := NewVoteStorage(m, trmpgx.DefaultCtxGetter)
+			prev, err := st.SetVote(context.Background(), vote)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPrev, prev)
+		})
+	}
+}
+
+func TestVoteStorage_AddVoteCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockDB(ctrl)
+	// порядок аргументов: ctx, sql, up, down, targetID
+	m.EXPECT().QueryRow(gomock.Any(), gomock.Any(), int64(1), int64(-1), int64(5)).
+		Return(fakeRow{scan: func(dest ...any) error { return pgx.ErrNoRows }})
+
+	st := NewVoteStorage(m, trmpgx.DefaultCtxGetter)
+	err := st.AddVoteCounts(context.Background(), model.VoteTargetComment, 5, 1, -1)
+	require.ErrorIs(t, err, service.ErrNotFound)
+
+	err = st.AddVoteCounts(context.Background(), model.VoteTarget("user"), 5, 1, 0)
+	require.ErrorIs(t, err, service.ErrInvalidRequest)
+}
+
+func TestVoteStorage_GetVotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockDB(ctrl)
+
+	rows := pgxmock.NewRows([]string{"target_id", "value"}).
+		AddRow(int64(2), int8(-1)).
+		Kind()
+
+	// порядок аргументов: ctx, sql, target_type, user_id, ids
+	m.EXPECT().
+		Query(gomock.Any(), gomock.Any(), "comment", int64(7), []int64{1, 2}).
+		DoAndReturn(func(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+			require.Contains(t, sql, "target_id = ANY($3)")
+			return rows, nil
+		})
+
+	st := NewVoteStorage(m, trmpgx.DefaultCtxGetter)
+	got, err := st.GetVotes(context.Background(), 7, model.VoteTargetComment, []int64{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []model.Vote{{UserID: 7, TargetType: model.VoteTargetComment, TargetID: 2, Value: -1}}, got)
+
+	got, err = st.GetVotes(context.Background(), 7, model.VoteTargetComment, nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
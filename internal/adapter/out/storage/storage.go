@@ -2,29 +2,55 @@ package storage
 
 import (
 	"errors"
+	"myreddit/internal/model"
 	"myreddit/pkg/pagination"
+	"time"
 )
 
-type Direction int
+// Direction — направление чтения относительно курсора, общее с пагинатором
+type Direction = pagination.Direction
 
 const (
-	DirectionUnspecified Direction = iota
-	DirectionAfter
-	DirectionBefore
+	DirectionUnspecified = pagination.DirectionUnspecified
+	DirectionAfter       = pagination.DirectionAfter
+	DirectionBefore      = pagination.DirectionBefore
 )
 
 var (
 	ErrDirectionUnset = errors.New("direction must be set")
 )
 
+// PostFeed — порядок и фильтр ленты постов
+type PostFeed struct {
+	Sort model.PostSort
+	// Since — нижняя граница created_at, нулевое время — без ограничения
+	Since time.Time
+}
+
 type GetPostsParams struct {
+	Feed      PostFeed
 	Cursor    pagination.Cursor
 	Direction Direction
 	Limit     int
 }
 
+type UpdatePostParams struct {
+	PostID int64
+	// nil — поле не меняется
+	Title *string
+	Text  *string
+}
+
+// CommentFeed — порядок и фильтр комментариев поста
+type CommentFeed struct {
+	Sort model.CommentSort
+	// IncludeReplies — отдавать ответы вместе с корневыми одним плоским списком
+	IncludeReplies bool
+}
+
 type GetCommentsParams struct {
 	PostID    int64
+	Feed      CommentFeed
 	Cursor    pagination.Cursor
 	Direction Direction
 	Limit     int
@@ -33,7 +59,17 @@ type GetCommentsParams struct {
 type GetRepliesParams struct {
 	PostID    int64
 	ParentID  int64
+	Sort      model.CommentSort
 	Cursor    pagination.Cursor
 	Direction Direction
 	Limit     int
 }
+
+// GetCommentTreeParams — ограничения обхода дерева комментариев поста.
+type GetCommentTreeParams struct {
+	PostID      int64
+	Sort        model.CommentSort
+	MaxDepth    int
+	MaxChildren int
+	MaxNodes    int
+}
@@ -3,39 +3,67 @@ package app
 
 import (
 	"context"
+	"expvar"
 	"fmt"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"myreddit/config"
 	gqlin "myreddit/internal/adapter/in/graphql"
-	inmemorybus "myreddit/internal/adapter/out/commentbus/inmemory"
+	inmemorybus "myreddit/internal/adapter/out/eventbus/inmemory"
+	pgbus "myreddit/internal/adapter/out/eventbus/postgres"
 	memstore "myreddit/internal/adapter/out/storage/inmemory"
 	pgstore "myreddit/internal/adapter/out/storage/postgres"
 	"myreddit/internal/service"
+	"myreddit/pkg/auth"
 	"myreddit/pkg/logger"
+	"myreddit/pkg/pagination"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
-	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type App struct {
-	cfg  config.Config
-	srv  *http.Server
-	pool *pgxpool.Pool
+	cfg           config.Config
+	srv           *http.Server
+	pool          *pgxpool.Pool
+	subscriptions *gqlin.SubscriptionLimiter
+	// ready — отвечает ли /readyz готовностью; снимается первым шагом остановки
+	ready *atomic.Bool
+	// workers — фоновые задачи, работают до остановки приложения
+	workers []worker
+}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context) error
 }
 
 func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
 	log := logger.FromContext(ctx)
 
+	cursors, err := newCursorCodec(cfg.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		postStorage    service.PostStorage
 		commentStorage service.CommentStorage
+		voteStorage    service.VoteStorage
+		outboxStorage  service.OutboxStorage
+		txManager      service.TxManager
+		savepoints     service.TxManager
 		pool           *pgxpool.Pool
 	)
 
@@ -48,34 +76,112 @@ func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
 		}
 		postStorage = pgstore.NewPostStorage(pool, trmpgx.DefaultCtxGetter)
 		commentStorage = pgstore.NewCommentStorage(pool, trmpgx.DefaultCtxGetter)
+		voteStorage = pgstore.NewVoteStorage(pool, trmpgx.DefaultCtxGetter)
+		outboxStorage = pgstore.NewOutboxStorage(pool, trmpgx.DefaultCtxGetter)
+		txManager = manager.Must(trmpgx.NewDefaultFactory(pool))
+		savepoints = manager.Must(trmpgx.NewDefaultFactory(pool), manager.WithSettings(
+			trmpgx.MustSettings(settings.Must(settings.WithPropagation(trm.PropagationNested))),
+		))
 
 	default:
-		postStorage = memstore.NewPostStorage()
-		commentStorage = memstore.NewCommentStorage()
+		posts := memstore.NewPostStorage()
+		comments := memstore.NewCommentStorage()
+		posts.AttachComments(comments)
+		postStorage = posts
+		commentStorage = comments
+		votes := memstore.NewVoteStorage(posts, comments)
+		posts.AttachVotes(votes)
+		voteStorage = votes
+		outboxStorage = memstore.NewOutboxStorage()
+		txManager = memstore.TxManager{}
+		savepoints = memstore.TxManager{}
 	}
 
-	bus := inmemorybus.New()
+	policy, err := inmemorybus.ParseSlowPolicy(cfg.CommentBus.SlowPolicy)
+	if err != nil {
+		return nil, err
+	}
+	subscribers := inmemorybus.Config{BufferSize: cfg.CommentBus.BufferSize, Policy: policy}
 
-	postSvc := service.NewPostService(postStorage)
-	commentSvc := service.NewCommentService(commentStorage, bus, postStorage)
+	var (
+		bus     service.EventBus
+		workers []worker
+	)
+	switch cfg.CommentBus.Type {
+	case "postgres":
+		if pool == nil {
+			return nil, fmt.Errorf("event bus postgres requires postgres storage")
+		}
+		pgBus := pgbus.New(pool, trmpgx.DefaultCtxGetter, pgbus.ConnectFrom(pool.Config().ConnConfig), postStorage, commentStorage, cfg.CommentBus.Channel, subscribers)
+		expvar.Publish("event_bus", pgBus.Stats())
+		bus = pgBus
+		workers = append(workers, worker{name: "event bus listener", run: pgBus.Listen})
+	default:
+		memBus := inmemorybus.New(subscribers)
+		expvar.Publish("event_bus", memBus.Stats())
+		bus = memBus
+	}
+
+	postSvc := service.NewPostService(postStorage, bus, outboxStorage, txManager, cursors)
+	relay := service.NewOutboxRelay(outboxStorage, postStorage, commentStorage, bus, txManager, savepoints, service.OutboxConfig{
+		PollInterval: time.Duration(cfg.Outbox.PollIntervalMS) * time.Millisecond,
+		BatchSize:    cfg.Outbox.BatchSize,
+		MaxAttempts:  cfg.Outbox.MaxAttempts,
+	})
+	expvar.Publish("outbox", relay.Stats())
+	workers = append(workers, worker{name: "outbox relay", run: relay.Run})
+
+	commentSvc := service.NewCommentService(commentStorage, bus, postStorage, outboxStorage, txManager, cursors, service.CommentConfig{
+		MaxDepth: cfg.Comments.MaxDepth,
+	})
+	voteSvc := service.NewVoteService(voteStorage, postStorage, commentStorage, txManager)
 
-	resolver := gqlin.NewResolver(postSvc, commentSvc)
+	resolver := gqlin.NewResolver(postSvc, commentSvc, voteSvc, gqlin.ResolverConfig{
+		LegacyUserIDArgs: cfg.Auth.LegacyUserIDArgs,
+		LegacyNumericIDs: cfg.GraphQL.LegacyNumericIDs,
+	})
 	es := gqlin.NewExecutableSchema(gqlin.Config{Resolvers: resolver})
 	gqlSrv := handler.New(es)
-
-	gqlSrv.AddTransport(transport.POST{})
-	gqlSrv.AddTransport(&transport.Websocket{
-		KeepAlivePingInterval: time.Duration(cfg.WS.KeepAliveSeconds) * time.Second,
+	gqlSrv.SetErrorPresenter(gqlin.ErrorPresenter)
+	gqlSrv.AroundOperations(resolver.Dataloaders)
+	limiter := gqlin.NewSubscriptionLimiter(gqlin.SubscriptionLimits{
+		PerConnection: cfg.Subscriptions.MaxPerConnection,
+		PerUser:       cfg.Subscriptions.MaxPerUser,
 	})
+	gqlSrv.AroundOperations(limiter.AroundOperations)
+	gqlSrv.AroundOperations(gqlin.StreamErrors)
+
+	tokens, err := auth.NewTokenManager([]byte(cfg.Auth.JWTSecret))
+	if err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	checkOrigin := originChecker(cfg.WS.AllowedOrigins)
+	transports, err := graphqlTransports(cfg.GraphQL.Transports, time.Duration(cfg.WS.KeepAliveSeconds)*time.Second,
+		wsInit(tokens, limiter), checkOrigin)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range transports {
+		gqlSrv.AddTransport(t)
+	}
 	gqlSrv.Use(extension.Introspection{})
 
 	mux := http.NewServeMux()
-	mux.Handle("/query", gqlSrv)
+	mux.Handle("/query", requestIDMiddleware(originMiddleware(checkOrigin, streamMiddleware(authMiddleware(tokens, gqlSrv)))))
 	mux.Handle("/", playground.Handler("GraphQL Playground", "/query"))
+	mux.Handle("/debug/vars", expvar.Handler())
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
 	})
+	ready := new(atomic.Bool)
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
 
 	addr := ":" + cfg.HTTP.Port
 	srv := &http.Server{
@@ -87,34 +193,100 @@ func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
 		IdleTimeout:       60 * time.Second,
 	}
 
-	log.Info("app initialized", "addr", addr, "storage", cfg.StorageType)
-	return &App{cfg: cfg, srv: srv, pool: pool}, nil
+	log.Info("app initialized", "addr", addr, "storage", cfg.StorageType, "comment_bus", cfg.CommentBus.Type)
+	return &App{cfg: cfg, srv: srv, pool: pool, subscriptions: limiter, ready: ready, workers: workers}, nil
 }
 
 func (a *App) Run(ctx context.Context) error {
 	log := logger.FromContext(ctx)
 
+	// порт занимается до старта воркеров и до готовности
+	ln, err := net.Listen("tcp", a.srv.Addr)
+	if err != nil {
+		if a.pool != nil {
+			a.pool.Close()
+		}
+		return fmt.Errorf("http server: %w", err)
+	}
+
+	// воркеры живут дольше ctx
+	workersCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+	for _, w := range a.workers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			if err := w.run(workersCtx); err != nil {
+				log.Error("worker failed", "worker", w.name, "error", err)
+			}
+		}()
+	}
+
 	errCh := make(chan error, 1)
 	go func() {
-		log.Info("http server listening", "addr", a.srv.Addr)
-		errCh <- a.srv.ListenAndServe()
+		errCh <- a.srv.Serve(ln)
 	}()
+	log.Info("http server listening", "addr", a.srv.Addr)
+	a.ready.Store(true)
 
 	select {
 	case <-ctx.Done():
 		log.Info("shutdown requested")
-		shCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		_ = a.srv.Shutdown(shCtx)
-		if a.pool != nil {
-			a.pool.Close()
-		}
-		return nil
+	case err = <-errCh:
+	}
 
-	case err := <-errCh:
-		if a.pool != nil {
-			a.pool.Close()
-		}
-		return err
+	a.shutdown(context.WithoutCancel(ctx), stopWorkers, &workers)
+	return err
+}
+
+// shutdown останавливает приложение по шагам
+func (a *App) shutdown(ctx context.Context, stopWorkers context.CancelFunc, workers *sync.WaitGroup) {
+	log := logger.FromContext(ctx)
+
+	a.ready.Store(false)
+	if delay := time.Duration(a.cfg.Shutdown.ReadinessDelaySeconds) * time.Second; delay > 0 {
+		log.Info("not ready, waiting before drain", "delay", delay)
+		time.Sleep(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.cfg.Shutdown.GracePeriodSeconds)*time.Second)
+	defer cancel()
+
+	if err := a.subscriptions.Shutdown(ctx); err != nil {
+		log.Warn("subscriptions not drained", "error", err)
+	}
+	if err := a.srv.Shutdown(ctx); err != nil {
+		log.Warn("http server not drained", "error", err)
+	}
+
+	stopWorkers()
+	stopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warn("workers not stopped", "error", ctx.Err())
+	}
+
+	if a.pool != nil {
+		a.pool.Close()
+	}
+	log.Info("shutdown complete")
+}
+
+func newCursorCodec(cfg config.PaginationConfig) (*pagination.Codec, error) {
+	keys := make([][]byte, 0, len(cfg.CursorSecrets))
+	for _, s := range cfg.CursorSecrets {
+		keys = append(keys, []byte(s))
+	}
+	codec, err := pagination.NewCodec(keys...)
+	if err != nil {
+		return nil, fmt.Errorf("cursor codec: %w", err)
 	}
+	return codec, nil
 }
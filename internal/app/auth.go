@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"myreddit/pkg/auth"
+	"myreddit/pkg/logger"
+)
+
+// authMiddleware проверяет bearer-токен и кладёт ID пользователя в контекст.
+func authMiddleware(tokens *auth.TokenManager, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, err := bearerUser(r.Context(), tokens, header)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithUserID(r.Context(), userID)))
+	})
+}
+
+var (
+	errInvalidAuthHeader = errors.New("invalid authorization header")
+	errInvalidToken      = errors.New("invalid token")
+)
+
+// bearerUser разбирает значение "Bearer <token>".
+func bearerUser(ctx context.Context, tokens *auth.TokenManager, header string) (int64, error) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return 0, errInvalidAuthHeader
+	}
+
+	userID, err := tokens.Parse(strings.TrimSpace(token))
+	if err != nil {
+		logger.FromContext(ctx).Debug("token rejected", "error", err)
+		return 0, errInvalidToken
+	}
+	return userID, nil
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"net/http"
+
+	"myreddit/pkg/logger"
+	"myreddit/pkg/requestid"
+)
+
+// requestIDMiddleware присваивает запросу ID корреляции
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+		w.Header().Set(requestid.Header, id)
+
+		ctx := requestid.WithID(r.Context(), id)
+		ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("request_id", id))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	gqlin "myreddit/internal/adapter/in/graphql"
+	"myreddit/pkg/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+)
+
+// graphqlTransports собирает включенные транспорты /query.
+func graphqlTransports(names []string, keepAlive time.Duration, wsInit transport.WebsocketInitFunc, checkOrigin func(*http.Request) bool) ([]graphql.Transport, error) {
+	for _, name := range names {
+		if !slices.Contains([]string{"sse", "post", "get", "websocket"}, name) {
+			return nil, fmt.Errorf("unknown graphql transport %q", name)
+		}
+	}
+
+	var out []graphql.Transport
+	if slices.Contains(names, "sse") {
+		out = append(out, transport.SSE{KeepAlivePingInterval: keepAlive})
+	}
+	if slices.Contains(names, "post") {
+		out = append(out, transport.POST{})
+	}
+	if slices.Contains(names, "get") {
+		// мутации по GET транспорт отклоняет сам
+		out = append(out, transport.GET{})
+	}
+	if slices.Contains(names, "websocket") {
+		out = append(out, &transport.Websocket{
+			Upgrader:              websocket.Upgrader{CheckOrigin: checkOrigin},
+			InitFunc:              wsInit,
+			KeepAlivePingInterval: keepAlive,
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no graphql transports enabled")
+	}
+	return out, nil
+}
+
+// streamMiddleware снимает с SSE-подписок WriteTimeout сервера
+func streamMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// wsInit проверяет токен из connection_init и заводит соединению счетчик подписок.
+func wsInit(tokens *auth.TokenManager, limiter *gqlin.SubscriptionLimiter) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		if header := payload.Authorization(); header != "" {
+			userID, err := bearerUser(ctx, tokens, header)
+			if err != nil {
+				return ctx, nil, err
+			}
+			ctx = auth.WithUserID(ctx, userID)
+		}
+		return limiter.WithConnection(ctx), nil, nil
+	}
+}
+
+// originChecker проверяет Origin websocket-запроса.
+func originChecker(allowed []string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if len(allowed) == 0 {
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		}
+		return slices.ContainsFunc(allowed, func(a string) bool {
+			return a == "*" || strings.EqualFold(a, origin)
+		})
+	}
+}
+
+// originMiddleware отклоняет websocket с чужого Origin до gqlgen.
+func originMiddleware(check func(*http.Request) bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(strings.ToLower(r.Header.Get("Upgrade")), "websocket") && !check(r) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -9,4 +9,27 @@ type Comment struct {
 	UserID    int64
 	Body      string
 	CreatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt *time.Time
+	Upvotes   int64
+	Downvotes int64
+	// BestRank и Controversy считаются хранилищем из голосов
+	BestRank    float64
+	Controversy float64
+	// ReplyCount — число прямых ответов, включая удаленные
+	ReplyCount int64
+	// Path — id предков от корня до родителя, Depth — глубина (у корневых 0)
+	Path  []int64
+	Depth int
+}
+
+// DeletedCommentBody — текст, который показывается вместо удаленного комментария
+const DeletedCommentBody = "[deleted]"
+
+func (c Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+func (c Comment) Score() int64 {
+	return c.Upvotes - c.Downvotes
 }
@@ -0,0 +1,23 @@
+package model
+
+// CommentTree — ветка обсуждения поста, загруженная одним запросом
+type CommentTree struct {
+	Roots []*CommentNode
+	// More — есть непоказанные корневые комментарии
+	More *MoreComments
+}
+
+type CommentNode struct {
+	Comment Comment
+	Replies []*CommentNode
+	// More — у комментария есть ответы, не попавшие в дерево из-за ограничений
+	More *MoreComments
+}
+
+// MoreComments — заглушка «еще ответы» на месте обрезанной части дерева
+type MoreComments struct {
+	// ParentID — чьи ответы не показаны, nil — корневые комментарии поста
+	ParentID *int64
+	// Cursor — курсор последнего показанного комментария
+	Cursor *string
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type OutboxTopic string
+
+const (
+	OutboxTopicCommentAdded   OutboxTopic = "comment_added"
+	OutboxTopicCommentEdited  OutboxTopic = "comment_edited"
+	OutboxTopicCommentDeleted OutboxTopic = "comment_deleted"
+	OutboxTopicPostAdded      OutboxTopic = "post_added"
+	OutboxTopicPostEdited     OutboxTopic = "post_edited"
+	OutboxTopicPostDeleted    OutboxTopic = "post_deleted"
+)
+
+// OutboxEvent — событие, записанное в одной транзакции с изменением данных.
+type OutboxEvent struct {
+	ID     int64
+	Topic  OutboxTopic
+	PostID int64
+	// CommentID — 0 у событий постов
+	CommentID int64
+	// Attempts — число неудачных попыток доставки
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -9,4 +9,14 @@ type Post struct {
 	UserID          int64
 	CommentsEnabled bool
 	CreatedAt       time.Time
+	EditedAt        *time.Time
+	Upvotes         int64
+	Downvotes       int64
+	// HotRank и Controversy считаются хранилищем из голосов и времени создания
+	HotRank     float64
+	Controversy float64
+}
+
+func (p Post) Score() int64 {
+	return p.Upvotes - p.Downvotes
 }
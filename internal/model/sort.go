@@ -0,0 +1,76 @@
+package model
+
+import "time"
+
+// PostSort — порядок ленты постов
+type PostSort int
+
+const (
+	PostSortNew PostSort = iota
+	PostSortHot
+	PostSortTop
+	PostSortControversial
+)
+
+func (s PostSort) String() string {
+	switch s {
+	case PostSortHot:
+		return "hot"
+	case PostSortTop:
+		return "top"
+	case PostSortControversial:
+		return "controversial"
+	default:
+		return "new"
+	}
+}
+
+// TimeWindow ограничивает ленту постами, созданными не раньше чем window назад
+type TimeWindow int
+
+const (
+	TimeWindowAll TimeWindow = iota
+	TimeWindowDay
+	TimeWindowWeek
+	TimeWindowMonth
+)
+
+// Since возвращает нижнюю границу created_at; нулевое время — без ограничения.
+func (w TimeWindow) Since(now time.Time) time.Time {
+	switch w {
+	case TimeWindowDay:
+		return now.AddDate(0, 0, -1)
+	case TimeWindowWeek:
+		return now.AddDate(0, 0, -7)
+	case TimeWindowMonth:
+		return now.AddDate(0, -1, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// CommentSort — порядок комментариев и ответов
+type CommentSort int
+
+const (
+	CommentSortNew CommentSort = iota
+	CommentSortOld
+	CommentSortTop
+	CommentSortBest
+	CommentSortControversial
+)
+
+func (s CommentSort) String() string {
+	switch s {
+	case CommentSortOld:
+		return "old"
+	case CommentSortTop:
+		return "top"
+	case CommentSortBest:
+		return "best"
+	case CommentSortControversial:
+		return "controversial"
+	default:
+		return "new"
+	}
+}
@@ -0,0 +1,31 @@
+package model
+
+type VoteTarget string
+
+const (
+	VoteTargetPost    VoteTarget = "post"
+	VoteTargetComment VoteTarget = "comment"
+)
+
+// Vote — голос пользователя за пост или комментарий.
+type Vote struct {
+	UserID     int64
+	TargetType VoteTarget
+	TargetID   int64
+	Value      int8
+}
+
+// VoteDelta возвращает изменение счетчиков upvotes/downvotes при смене голоса с prev на next.
+func VoteDelta(prev, next int8) (up, down int64) {
+	if prev > 0 {
+		up--
+	} else if prev < 0 {
+		down--
+	}
+	if next > 0 {
+		up++
+	} else if next < 0 {
+		down++
+	}
+	return up, down
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"myreddit/pkg/auth"
+)
+
+// actorID возвращает ID пользователя, от имени которого выполняется действие.
+func actorID(ctx context.Context) (int64, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	return userID, nil
+}
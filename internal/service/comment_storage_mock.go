@@ -42,6 +42,21 @@ func (m *MockCommentStorage) EXPECT() *MockCommentStorageMockRecorder {
 	return m.recorder
 }
 
+// CountComments mocks base method.
+func (m *MockCommentStorage) CountComments(ctx context.Context, postID int64, feed storage.CommentFeed) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountComments", ctx, postID, feed)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountComments indicates an expected call of CountComments.
+func (mr *MockCommentStorageMockRecorder) CountComments(ctx, postID, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountComments", reflect.TypeOf((*MockCommentStorage)(nil).CountComments), ctx, postID, feed)
+}
+
 // CreateComment mocks base method.
 func (m *MockCommentStorage) CreateComment(ctx context.Context, req CreateCommentRequest) (model.Comment, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +72,21 @@ func (mr *MockCommentStorageMockRecorder) CreateComment(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentStorage)(nil).CreateComment), ctx, req)
 }
 
+// DeleteComment mocks base method.
+func (m *MockCommentStorage) DeleteComment(ctx context.Context, commentID int64) (model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, commentID)
+	ret0, _ := ret[0].(model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentStorageMockRecorder) DeleteComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentStorage)(nil).DeleteComment), ctx, commentID)
+}
+
 // GetCommentByID mocks base method.
 func (m *MockCommentStorage) GetCommentByID(ctx context.Context, commentID int64) (model.Comment, error) {
 	m.ctrl.T.Helper()
@@ -72,19 +102,49 @@ func (mr *MockCommentStorageMockRecorder) GetCommentByID(ctx, commentID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommentStorage)(nil).GetCommentByID), ctx, commentID)
 }
 
+// GetCommentTree mocks base method.
+func (m *MockCommentStorage) GetCommentTree(ctx context.Context, params storage.GetCommentTreeParams) ([]model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentTree", ctx, params)
+	ret0, _ := ret[0].([]model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentTree indicates an expected call of GetCommentTree.
+func (mr *MockCommentStorageMockRecorder) GetCommentTree(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentTree", reflect.TypeOf((*MockCommentStorage)(nil).GetCommentTree), ctx, params)
+}
+
+// GetCommentsByIDs mocks base method.
+func (m *MockCommentStorage) GetCommentsByIDs(ctx context.Context, ids []int64) ([]model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByIDs indicates an expected call of GetCommentsByIDs.
+func (mr *MockCommentStorageMockRecorder) GetCommentsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByIDs", reflect.TypeOf((*MockCommentStorage)(nil).GetCommentsByIDs), ctx, ids)
+}
+
 // GetCommentsByPost mocks base method.
-func (m *MockCommentStorage) GetCommentsByPost(ctx context.Context, postID int64, limit int) ([]model.Comment, error) {
+func (m *MockCommentStorage) GetCommentsByPost(ctx context.Context, postID int64, feed storage.CommentFeed, limit int) ([]model.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentsByPost", ctx, postID, limit)
+	ret := m.ctrl.Call(m, "GetCommentsByPost", ctx, postID, feed, limit)
 	ret0, _ := ret[0].([]model.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCommentsByPost indicates an expected call of GetCommentsByPost.
-func (mr *MockCommentStorageMockRecorder) GetCommentsByPost(ctx, postID, limit any) *gomock.Call {
+func (mr *MockCommentStorageMockRecorder) GetCommentsByPost(ctx, postID, feed, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPost", reflect.TypeOf((*MockCommentStorage)(nil).GetCommentsByPost), ctx, postID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByPost", reflect.TypeOf((*MockCommentStorage)(nil).GetCommentsByPost), ctx, postID, feed, limit)
 }
 
 // GetCommentsByPostWithCursor mocks base method.
@@ -103,18 +163,18 @@ func (mr *MockCommentStorageMockRecorder) GetCommentsByPostWithCursor(ctx, param
 }
 
 // GetReplies mocks base method.
-func (m *MockCommentStorage) GetReplies(ctx context.Context, postID, parentID int64, limit int) ([]model.Comment, error) {
+func (m *MockCommentStorage) GetReplies(ctx context.Context, postID, parentID int64, sort model.CommentSort, limit int) ([]model.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplies", ctx, postID, parentID, limit)
+	ret := m.ctrl.Call(m, "GetReplies", ctx, postID, parentID, sort, limit)
 	ret0, _ := ret[0].([]model.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReplies indicates an expected call of GetReplies.
-func (mr *MockCommentStorageMockRecorder) GetReplies(ctx, postID, parentID, limit any) *gomock.Call {
+func (mr *MockCommentStorageMockRecorder) GetReplies(ctx, postID, parentID, sort, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentStorage)(nil).GetReplies), ctx, postID, parentID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentStorage)(nil).GetReplies), ctx, postID, parentID, sort, limit)
 }
 
 // GetRepliesWithCursor mocks base method.
@@ -132,55 +192,17 @@ func (mr *MockCommentStorageMockRecorder) GetRepliesWithCursor(ctx, params any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepliesWithCursor", reflect.TypeOf((*MockCommentStorage)(nil).GetRepliesWithCursor), ctx, params)
 }
 
-// MockCommentBus is a mock of CommentBus interface.
-type MockCommentBus struct {
-	ctrl     *gomock.Controller
-	recorder *MockCommentBusMockRecorder
-	isgomock struct{}
-}
-
-// MockCommentBusMockRecorder is the mock recorder for MockCommentBus.
-type MockCommentBusMockRecorder struct {
-	mock *MockCommentBus
-}
-
-// NewMockCommentBus creates a new mock instance.
-func NewMockCommentBus(ctrl *gomock.Controller) *MockCommentBus {
-	mock := &MockCommentBus{ctrl: ctrl}
-	mock.recorder = &MockCommentBusMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCommentBus) EXPECT() *MockCommentBusMockRecorder {
-	return m.recorder
-}
-
-// Publish mocks base method.
-func (m *MockCommentBus) Publish(ctx context.Context, postID int64, c model.Comment) error {
+// UpdateComment mocks base method.
+func (m *MockCommentStorage) UpdateComment(ctx context.Context, commentID int64, body string) (model.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Publish", ctx, postID, c)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Publish indicates an expected call of Publish.
-func (mr *MockCommentBusMockRecorder) Publish(ctx, postID, c any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockCommentBus)(nil).Publish), ctx, postID, c)
-}
-
-// Subscribe mocks base method.
-func (m *MockCommentBus) Subscribe(ctx context.Context, postID int64) (<-chan model.Comment, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscribe", ctx, postID)
-	ret0, _ := ret[0].(<-chan model.Comment)
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, commentID, body)
+	ret0, _ := ret[0].(model.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Subscribe indicates an expected call of Subscribe.
-func (mr *MockCommentBusMockRecorder) Subscribe(ctx, postID any) *gomock.Call {
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockCommentStorageMockRecorder) UpdateComment(ctx, commentID, body any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockCommentBus)(nil).Subscribe), ctx, postID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentStorage)(nil).UpdateComment), ctx, commentID, body)
 }
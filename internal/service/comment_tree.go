@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/internal/model"
+	"myreddit/pkg/logger"
+	"myreddit/pkg/pagination"
+)
+
+// Ограничения дерева комментариев
+const (
+	DefaultCommentTreeDepth    = 3
+	MaxCommentTreeDepth        = 8
+	DefaultCommentTreeChildren = 10
+	MaxCommentTreeChildren     = 50
+	MaxCommentTreeNodes        = 500
+)
+
+// GetCommentTree отдает дерево комментариев поста одним запросом.
+func (s *CommentService) GetCommentTree(ctx context.Context, req CommentTreeRequest) (model.CommentTree, error) {
+	if req.PostID <= 0 {
+		return model.CommentTree{}, fmt.Errorf("postID must be > 0: %w", ErrInvalidRequest)
+	}
+
+	if _, err := s.postStorage.GetPostByID(ctx, req.PostID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return model.CommentTree{}, fmt.Errorf("post: %w", err)
+		}
+		logger.FromContext(ctx).Error("error getting post by id", "error", err)
+		return model.CommentTree{}, err
+	}
+
+	params := toGetCommentTreeParams(req)
+	items, err := s.commentStorage.GetCommentTree(ctx, params)
+	if err != nil {
+		return model.CommentTree{}, err
+	}
+	return buildCommentTree(items, params, s.cursors), nil
+}
+
+// buildCommentTree собирает дерево из узлов, отданных хранилищем по уровням.
+func buildCommentTree(items []model.Comment, params storage.GetCommentTreeParams, cursors *pagination.Codec) model.CommentTree {
+	var tree model.CommentTree
+	nodes := make(map[int64]*model.CommentNode, len(items))
+	order := make([]*model.CommentNode, 0, len(items))
+
+	for _, c := range items {
+		n := &model.CommentNode{Comment: c}
+		if c.ParentID == nil {
+			if len(tree.Roots) == params.MaxChildren {
+				tree.More = &model.MoreComments{Cursor: lastCursor(cursors, params.Sort, commentsScope(params.PostID, false), tree.Roots)}
+				continue
+			}
+			tree.Roots = append(tree.Roots, n)
+		} else {
+			parent, ok := nodes[*c.ParentID]
+			if !ok {
+				continue
+			}
+			parent.Replies = append(parent.Replies, n)
+		}
+		nodes[c.ID] = n
+		order = append(order, n)
+	}
+
+	for _, n := range order {
+		if int64(len(n.Replies)) < n.Comment.ReplyCount {
+			id := n.Comment.ID
+			n.More = &model.MoreComments{ParentID: &id, Cursor: lastCursor(cursors, params.Sort, repliesScope(id), n.Replies)}
+		}
+	}
+	return tree
+}
+
+// lastCursor — курсор последнего узла для продолжения в выдаче scope.
+func lastCursor(cursors *pagination.Codec, sort model.CommentSort, scope string, nodes []*model.CommentNode) *string {
+	if len(nodes) == 0 {
+		return nil
+	}
+	cur := CommentCursor(sort, nodes[len(nodes)-1].Comment)
+	cur.Scope = scope
+	return cursors.Encode(cur)
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/internal/model"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentService_GetCommentTree(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+
+	ptr := func(v int64) *int64 { return &v }
+	ms.EXPECT().
+		GetCommentTree(gomock.Any(), storage.GetCommentTreeParams{
+			PostID: 10, Sort: model.CommentSortNew, MaxDepth: 2, MaxChildren: 2, MaxNodes: MaxCommentTreeNodes,
+		}).
+		Return([]model.Comment{
+			{ID: 5, PostID: 10, ReplyCount: 3},
+			{ID: 4, PostID: 10},
+			{ID: 1, PostID: 10}, // лишний корневой
+			{ID: 8, PostID: 10, ParentID: ptr(5), ReplyCount: 1},
+			{ID: 7, PostID: 10, ParentID: ptr(5)},
+		}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	tree, err := svc.GetCommentTree(context.Background(), CommentTreeRequest{PostID: 10, MaxDepth: 2, MaxChildren: 2})
+	require.NoError(t, err)
+
+	require.Len(t, tree.Roots, 2)
+	require.NotNil(t, tree.More)
+	require.Nil(t, tree.More.ParentID)
+	// курсоры more принимаются comments(postId) и replies(parentId)
+	roots := svc.commentPaginator(model.CommentSortNew, commentsScope(10, false))
+	require.Equal(t, roots.Encode(tree.Roots[1].Comment), tree.More.Cursor)
+
+	// ширина: показаны 2 из 3 ответов, продолжение после 7
+	root := tree.Roots[0]
+	require.Len(t, root.Replies, 2)
+	require.NotNil(t, root.More)
+	require.Equal(t, int64(5), *root.More.ParentID)
+	replies := svc.commentPaginator(model.CommentSortNew, repliesScope(5))
+	require.Equal(t, replies.Encode(root.Replies[1].Comment), root.More.Cursor)
+
+	// глубина: ответы 8 не загружались, продолжение с начала
+	deep := root.Replies[0]
+	require.Empty(t, deep.Replies)
+	require.NotNil(t, deep.More)
+	require.Nil(t, deep.More.Cursor)
+
+	require.Nil(t, tree.Roots[1].More)
+}
+
+// Хранилище обрезало уровень лимитом узлов: у узлов, чьи ответы не вошли
+// целиком или не загружались, есть продолжение.
+func TestCommentService_GetCommentTree_NodeBudget(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+
+	ptr := func(v int64) *int64 { return &v }
+	ms.EXPECT().
+		GetCommentTree(gomock.Any(), gomock.Any()).
+		Return([]model.Comment{
+			{ID: 1, PostID: 10, ReplyCount: 2},
+			{ID: 2, PostID: 10, ReplyCount: 2},
+			{ID: 3, PostID: 10},
+			{ID: 11, PostID: 10, ParentID: ptr(1), ReplyCount: 3},
+			{ID: 12, PostID: 10, ParentID: ptr(1)},
+			{ID: 21, PostID: 10, ParentID: ptr(2), ReplyCount: 1},
+		}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	tree, err := svc.GetCommentTree(context.Background(), CommentTreeRequest{PostID: 10, MaxDepth: 3, MaxChildren: 2})
+	require.NoError(t, err)
+
+	nodes := 0
+	var walk func([]*model.CommentNode)
+	walk = func(level []*model.CommentNode) {
+		for _, n := range level {
+			nodes++
+			walk(n.Replies)
+		}
+	}
+	walk(tree.Roots)
+	require.Equal(t, 5, nodes)
+	require.NotNil(t, tree.More)
+
+	first, second := tree.Roots[0], tree.Roots[1]
+	require.Nil(t, first.More)
+	require.Len(t, second.Replies, 1)
+	require.NotNil(t, second.More)
+	replies := svc.commentPaginator(model.CommentSortNew, repliesScope(second.Comment.ID))
+	require.Equal(t, replies.Encode(second.Replies[0].Comment), second.More.Cursor)
+
+	// ответы 11 и 21 не загружались: продолжение с начала
+	require.Nil(t, first.Replies[0].More.Cursor)
+	require.Nil(t, second.Replies[0].More.Cursor)
+	require.Nil(t, first.Replies[1].More)
+}
+
+func TestCommentService_GetCommentTree_Limits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   CommentTreeRequest
+		want storage.GetCommentTreeParams
+	}{
+		{
+			name: "defaults",
+			in:   CommentTreeRequest{PostID: 1},
+			want: storage.GetCommentTreeParams{PostID: 1, MaxDepth: DefaultCommentTreeDepth, MaxChildren: DefaultCommentTreeChildren, MaxNodes: MaxCommentTreeNodes},
+		},
+		{
+			name: "capped",
+			in:   CommentTreeRequest{PostID: 1, Sort: model.CommentSortTop, MaxDepth: 100, MaxChildren: 1000},
+			want: storage.GetCommentTreeParams{PostID: 1, Sort: model.CommentSortTop, MaxDepth: MaxCommentTreeDepth, MaxChildren: MaxCommentTreeChildren, MaxNodes: MaxCommentTreeNodes},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, toGetCommentTreeParams(tt.in))
+		})
+	}
+}
+
+func TestCommentService_GetCommentTree_PostNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{}, ErrNotFound)
+
+	svc := NewCommentService(NewMockCommentStorage(ctrl), nil, mp, nil, nil, testCursors, CommentConfig{})
+	_, err := svc.GetCommentTree(context.Background(), CommentTreeRequest{PostID: 10})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = svc.GetCommentTree(context.Background(), CommentTreeRequest{})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"myreddit/internal/model"
 	"myreddit/pkg/logger"
 	"myreddit/pkg/pagination"
+	"slices"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -16,40 +18,65 @@ const (
 	DefaultCommentsLimit = 50
 	MaxCommentsLimit     = 250
 	MaxCommentTextLen    = 2000
+	// DefaultMaxCommentDepth — допустимая глубина ответа, если в CommentConfig не задана
+	DefaultMaxCommentDepth = 10
 )
 
 //go:generate mockgen -source=comments.go -destination=./comment_storage_mock.go -package=service myreddit/internal/service CommentStorage
 type CommentStorage interface {
 	CreateComment(ctx context.Context, req CreateCommentRequest) (model.Comment, error)
 	GetCommentByID(ctx context.Context, commentID int64) (model.Comment, error)
-	GetCommentsByPost(ctx context.Context, postID int64, limit int) ([]model.Comment, error)
-	GetReplies(ctx context.Context, postID, parentID int64, limit int) ([]model.Comment, error)
+	GetCommentsByIDs(ctx context.Context, ids []int64) ([]model.Comment, error)
+	GetCommentsByPost(ctx context.Context, postID int64, feed storage.CommentFeed, limit int) ([]model.Comment, error)
+	GetReplies(ctx context.Context, postID, parentID int64, sort model.CommentSort, limit int) ([]model.Comment, error)
 	GetCommentsByPostWithCursor(ctx context.Context, params storage.GetCommentsParams) ([]model.Comment, error)
 	GetRepliesWithCursor(ctx context.Context, params storage.GetRepliesParams) ([]model.Comment, error)
+	CountComments(ctx context.Context, postID int64, feed storage.CommentFeed) (int, error)
+	GetCommentTree(ctx context.Context, params storage.GetCommentTreeParams) ([]model.Comment, error)
+	UpdateComment(ctx context.Context, commentID int64, body string) (model.Comment, error)
+	DeleteComment(ctx context.Context, commentID int64) (model.Comment, error)
 }
 
-type CommentBus interface {
-	Subscribe(ctx context.Context, postID int64) (<-chan model.Comment, error)
-	Publish(ctx context.Context, postID int64, c model.Comment) error
+type CommentConfig struct {
+	// MaxDepth — максимальная глубина вложенности ответа (у корневых 0)
+	MaxDepth int
 }
 
 type CommentService struct {
 	commentStorage CommentStorage
-	commentBus     CommentBus
+	eventBus       EventBus
 	postStorage    PostStorage
+	outbox         OutboxStorage
+	txManager      TxManager
+	cursors        *pagination.Codec
+	cfg            CommentConfig
 }
 
-func NewCommentService(commentsStorage CommentStorage, commentBus CommentBus, postStorage PostStorage) *CommentService {
+// NewCommentService — eventBus нужен только подпискам
+func NewCommentService(commentsStorage CommentStorage, eventBus EventBus, postStorage PostStorage, outbox OutboxStorage, txManager TxManager, cursors *pagination.Codec, cfg CommentConfig) *CommentService {
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = DefaultMaxCommentDepth
+	}
 	return &CommentService{
 		commentStorage: commentsStorage,
-		commentBus:     commentBus,
+		eventBus:       eventBus,
 		postStorage:    postStorage,
+		outbox:         outbox,
+		txManager:      txManager,
+		cursors:        cursors,
+		cfg:            cfg,
 	}
 }
 
 func (s *CommentService) CreateComment(ctx context.Context, req CreateCommentRequest) (model.Comment, error) {
+	userID, err := actorID(ctx)
+	if err != nil {
+		return model.Comment{}, err
+	}
+	req.UserID = userID
+
 	if err := validator.New().Struct(req); err != nil {
-		return model.Comment{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		return model.Comment{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
 	}
 	if len([]rune(req.Text)) > MaxCommentTextLen {
 		return model.Comment{}, fmt.Errorf("text too long: %w", ErrInvalidRequest)
@@ -68,199 +95,421 @@ func (s *CommentService) CreateComment(ctx context.Context, req CreateCommentReq
 	}
 
 	if req.ParentID != nil {
-		if _, err := s.commentStorage.GetCommentByID(ctx, *req.ParentID); err != nil {
+		parent, err := s.commentStorage.GetCommentByID(ctx, *req.ParentID)
+		if err != nil {
 			if errors.Is(err, ErrNotFound) {
 				return model.Comment{}, fmt.Errorf("parent-commment: %w", err)
 			}
 			logger.FromContext(ctx).Error("error getting parent-comment", "error", err)
 			return model.Comment{}, err
 		}
+		if parent.PostID != req.PostID {
+			return model.Comment{}, ErrParentPostMismatch
+		}
+		if parent.IsDeleted() {
+			return model.Comment{}, fmt.Errorf("parent-comment deleted: %w", ErrForbidden)
+		}
+		if parent.Depth+1 > s.cfg.MaxDepth {
+			return model.Comment{}, fmt.Errorf("max comment depth %d exceeded: %w", s.cfg.MaxDepth, ErrInvalidRequest)
+		}
 	}
 
-	comment, err := s.commentStorage.CreateComment(ctx, req)
+	return s.withEvent(ctx, model.OutboxTopicCommentAdded, func(ctx context.Context) (model.Comment, error) {
+		return s.commentStorage.CreateComment(ctx, req)
+	})
+}
+
+// withEvent выполняет изменение и записывает его событие в outbox в той же транзакции
+func (s *CommentService) withEvent(ctx context.Context, topic model.OutboxTopic, change func(ctx context.Context) (model.Comment, error)) (model.Comment, error) {
+	var comment model.Comment
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if comment, err = change(ctx); err != nil {
+			return err
+		}
+		return s.outbox.AddEvent(ctx, model.OutboxEvent{
+			Topic:     topic,
+			PostID:    comment.PostID,
+			CommentID: comment.ID,
+		})
+	})
 	if err != nil {
 		return model.Comment{}, err
 	}
-
-	s.commentBus.Publish(ctx, req.PostID, comment)
 	return comment, nil
 }
 
-func (s *CommentService) GetCommentByID(ctx context.Context, commentID int64) (model.Comment, error) {
+func (s *CommentService) EditComment(ctx context.Context, req EditCommentRequest) (model.Comment, error) {
+	if err := validator.New().Struct(req); err != nil {
+		return model.Comment{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	if len([]rune(req.Text)) > MaxCommentTextLen {
+		return model.Comment{}, fmt.Errorf("text too long: %w", ErrInvalidRequest)
+	}
+	if err := s.checkAuthor(ctx, req.CommentID); err != nil {
+		return model.Comment{}, err
+	}
+	return s.withEvent(ctx, model.OutboxTopicCommentEdited, func(ctx context.Context) (model.Comment, error) {
+		return s.commentStorage.UpdateComment(ctx, req.CommentID, req.Text)
+	})
+}
+
+// DeleteComment мягко удаляет комментарий, ответы на него остаются в дереве.
+func (s *CommentService) DeleteComment(ctx context.Context, commentID int64) (model.Comment, error) {
 	if commentID <= 0 {
 		return model.Comment{}, fmt.Errorf("commentID must be > 0: %w", ErrInvalidRequest)
 	}
-	return s.commentStorage.GetCommentByID(ctx, commentID)
+	if err := s.checkAuthor(ctx, commentID); err != nil {
+		return model.Comment{}, err
+	}
+	return s.withEvent(ctx, model.OutboxTopicCommentDeleted, func(ctx context.Context) (model.Comment, error) {
+		return s.commentStorage.DeleteComment(ctx, commentID)
+	})
 }
 
-func (s *CommentService) GetCommentsByPost(ctx context.Context, in pagination.PageRequest, postID int64) (pagination.Page[model.Comment], error) {
-	var (
-		items []model.Comment
-		err   error
-		page  pagination.Page[model.Comment]
-	)
-
-	if err := validatePagination(in); err != nil {
-		return page, err
+// checkAuthor проверяет, что действующий пользователь — автор живого комментария.
+func (s *CommentService) checkAuthor(ctx context.Context, commentID int64) error {
+	userID, err := actorID(ctx)
+	if err != nil {
+		return err
 	}
-
-	_, err = s.postStorage.GetPostByID(ctx, postID)
+	c, err := s.commentStorage.GetCommentByID(ctx, commentID)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return pagination.Page[model.Comment]{}, fmt.Errorf("post: %w", err)
+			return fmt.Errorf("comment: %w", err)
 		}
-		logger.FromContext(ctx).Error("error getting post by id", "error", err)
-		return pagination.Page[model.Comment]{}, err
+		return err
 	}
-
-	limit := in.Limit
-	if limit <= 0 {
-		limit = DefaultCommentsLimit
+	if c.IsDeleted() {
+		return fmt.Errorf("comment deleted: %w", ErrNotFound)
 	}
-	if limit > MaxCommentsLimit {
-		limit = MaxCommentsLimit
+	if c.UserID != userID {
+		return fmt.Errorf("%w: not a comment author", ErrForbidden)
 	}
-	peek := limit + 1
-
-	afterProvided := in.AfterCursor != nil && *in.AfterCursor != ""
-	beforeProvided := in.BeforeCursor != nil && *in.BeforeCursor != ""
+	return nil
+}
 
-	switch {
-	case !afterProvided && !beforeProvided:
-		items, err = s.commentStorage.GetCommentsByPost(ctx, postID, peek)
-		if err != nil {
-			return page, err
-		}
+func (s *CommentService) GetCommentByID(ctx context.Context, commentID int64) (model.Comment, error) {
+	if commentID <= 0 {
+		return model.Comment{}, fmt.Errorf("commentID must be > 0: %w", ErrInvalidRequest)
+	}
+	return s.commentStorage.GetCommentByID(ctx, commentID)
+}
 
-	default:
-		req, err := toGetCommentsRequest(postID, in)
-		if err != nil {
-			return page, err
-		}
-		req.Limit = peek
+// GetCommentsByIDs отдает найденные комментарии в произвольном порядке, отсутствующие пропускаются.
+func (s *CommentService) GetCommentsByIDs(ctx context.Context, ids []int64) ([]model.Comment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.commentStorage.GetCommentsByIDs(ctx, ids)
+}
 
-		items, err = s.commentStorage.GetCommentsByPostWithCursor(ctx, req)
-		if err != nil {
-			return page, err
-		}
+// GetAncestors отдает предков комментария от корневого до родителя.
+func (s *CommentService) GetAncestors(ctx context.Context, commentID int64) ([]model.Comment, error) {
+	c, err := s.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Path) == 0 {
+		return nil, nil
 	}
 
-	if len(items) == 0 {
-		page.Items = nil
-		page.Count = 0
-		page.HasNextPage = false
-		page.StartCursor = nil
-		page.EndCursor = nil
-		return page, nil
+	out, err := s.commentStorage.GetCommentsByIDs(ctx, c.Path)
+	if err != nil {
+		return nil, err
 	}
+	slices.SortFunc(out, func(a, b model.Comment) int { return cmp.Compare(a.Depth, b.Depth) })
+	return out, nil
+}
 
-	if len(items) > limit {
-		page.HasNextPage = true
-		items = items[:limit]
+// GetCommentsByPost отдает корневые комментарии поста, с includeReplies — все комментарии плоским списком.
+func (s *CommentService) GetCommentsByPost(ctx context.Context, in pagination.PageRequest, postID int64, sort model.CommentSort, includeReplies bool) (pagination.Page[model.Comment], error) {
+	if postID <= 0 {
+		return pagination.Page[model.Comment]{}, fmt.Errorf("postID must be > 0: %w", ErrInvalidRequest)
+	}
+	paginator := s.commentPaginator(sort, commentsScope(postID, includeReplies))
+	w, err := pageWindow(paginator, in)
+	if err != nil {
+		return pagination.Page[model.Comment]{}, err
+	}
+	if err := s.checkPost(ctx, postID); err != nil {
+		return pagination.Page[model.Comment]{}, err
 	}
 
-	page.Items = items
-	page.Count = len(items)
+	feed := storage.CommentFeed{Sort: sort, IncludeReplies: includeReplies}
+	fetch := func(ctx context.Context, cursor *pagination.Cursor, dir storage.Direction, limit int) ([]model.Comment, error) {
+		if cursor == nil {
+			return s.commentStorage.GetCommentsByPost(ctx, postID, feed, limit)
+		}
+		return s.commentStorage.GetCommentsByPostWithCursor(ctx, storage.GetCommentsParams{
+			PostID:    postID,
+			Feed:      feed,
+			Cursor:    *cursor,
+			Direction: dir,
+			Limit:     limit,
+		})
+	}
 
-	startCursor := pagination.Cursor{
-		CreatedAt: items[0].CreatedAt,
-		ID:        items[0].ID,
+	page, err := paginator.Load(ctx, w, fetch)
+	if err != nil {
+		return page, err
 	}
-	endCursor := pagination.Cursor{
-		CreatedAt: items[len(items)-1].CreatedAt,
-		ID:        items[len(items)-1].ID,
+	if in.WithTotal {
+		if page.TotalCount, err = s.commentStorage.CountComments(ctx, postID, feed); err != nil {
+			return pagination.Page[model.Comment]{}, err
+		}
 	}
-
-	page.StartCursor, page.EndCursor = startCursor.Encode(), endCursor.Encode()
 	return page, nil
 }
 
-func (s *CommentService) GetReplies(ctx context.Context, in pagination.PageRequest, postID, parentID int64) (pagination.Page[model.Comment], error) {
-	var (
-		items []model.Comment
-		err   error
-		page  pagination.Page[model.Comment]
-	)
+func (s *CommentService) GetReplies(ctx context.Context, in pagination.PageRequest, postID, parentID int64, sort model.CommentSort) (pagination.Page[model.Comment], error) {
+	if postID <= 0 {
+		return pagination.Page[model.Comment]{}, fmt.Errorf("postID must be > 0: %w", ErrInvalidRequest)
+	}
+	if parentID <= 0 {
+		return pagination.Page[model.Comment]{}, fmt.Errorf("parentID must be > 0: %w", ErrInvalidRequest)
+	}
+	paginator := s.commentPaginator(sort, repliesScope(parentID))
+	w, err := pageWindow(paginator, in)
+	if err != nil {
+		return pagination.Page[model.Comment]{}, err
+	}
+	if err := s.checkPost(ctx, postID); err != nil {
+		return pagination.Page[model.Comment]{}, err
+	}
 
-	if err := validatePagination(in); err != nil {
-		return page, err
+	fetch := func(ctx context.Context, cursor *pagination.Cursor, dir storage.Direction, limit int) ([]model.Comment, error) {
+		if cursor == nil {
+			return s.commentStorage.GetReplies(ctx, postID, parentID, sort, limit)
+		}
+		return s.commentStorage.GetRepliesWithCursor(ctx, storage.GetRepliesParams{
+			PostID:    postID,
+			ParentID:  parentID,
+			Sort:      sort,
+			Cursor:    *cursor,
+			Direction: dir,
+			Limit:     limit,
+		})
 	}
 
-	limit := in.Limit
-	if limit <= 0 {
-		limit = DefaultCommentsLimit
+	page, err := paginator.Load(ctx, w, fetch)
+	if err != nil {
+		return page, err
 	}
-	if limit > MaxCommentsLimit {
-		limit = MaxCommentsLimit
+	if in.WithTotal {
+		if page.TotalCount, err = s.replyCount(ctx, postID, parentID); err != nil {
+			return pagination.Page[model.Comment]{}, err
+		}
 	}
-	peek := limit + 1
+	return page, nil
+}
 
-	_, err = s.postStorage.GetPostByID(ctx, postID)
-	if err != nil {
+// checkPost проверяет, что пост существует.
+func (s *CommentService) checkPost(ctx context.Context, postID int64) error {
+	if _, err := s.postStorage.GetPostByID(ctx, postID); err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return pagination.Page[model.Comment]{}, fmt.Errorf("post: %w", err)
+			return fmt.Errorf("post: %w", err)
 		}
 		logger.FromContext(ctx).Error("error getting post by id", "error", err)
-		return pagination.Page[model.Comment]{}, err
+		return err
 	}
+	return nil
+}
 
-	afterProvided := in.AfterCursor != nil && *in.AfterCursor != ""
-	beforeProvided := in.BeforeCursor != nil && *in.BeforeCursor != ""
+// replyCount берет число ответов из счетчика родителя
+func (s *CommentService) replyCount(ctx context.Context, postID, parentID int64) (int, error) {
+	parent, err := s.commentStorage.GetCommentByID(ctx, parentID)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if parent.PostID != postID {
+		return 0, nil
+	}
+	return int(parent.ReplyCount), nil
+}
 
-	switch {
-	case !afterProvided && !beforeProvided:
-		items, err = s.commentStorage.GetReplies(ctx, postID, parentID, peek)
-		if err != nil {
-			return page, err
+// CommentCursor строит курсор комментария для порядка sort
+func CommentCursor(sort model.CommentSort, c model.Comment) pagination.Cursor {
+	cur := pagination.Cursor{Kind: pagination.KindComment, CreatedAt: c.CreatedAt, ID: c.ID}
+	switch sort {
+	case model.CommentSortOld:
+		cur.Sort = sort.String()
+	case model.CommentSortTop:
+		cur.Sort, cur.Score = sort.String(), c.Score()
+	case model.CommentSortBest:
+		cur.Sort, cur.Rank = sort.String(), c.BestRank
+	case model.CommentSortControversial:
+		cur.Sort, cur.Rank = sort.String(), c.Controversy
+	}
+	return cur
+}
+
+// Listen подписывает на новые комментарии поста.
+func (s *CommentService) Listen(ctx context.Context, postID int64, after *string) (*Stream[CommentAddedEvent], error) {
+	if s.eventBus == nil {
+		return nil, fmt.Errorf("no bus configured")
+	}
+
+	paginator := s.commentPaginator(model.CommentSortOld, commentsScope(postID, true))
+	w, err := pageWindow(paginator, pagination.PageRequest{AfterCursor: after})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	live, err := s.eventBus.Subscribe(ctx, CommentsTopic(postID))
+	if err != nil {
+		return nil, err
+	}
+	// пока идет догон, события копятся в очереди подписчика шины
+
+	out := make(chan CommentAddedEvent)
+	stream := &Stream[CommentAddedEvent]{C: out}
+	go func() {
+		defer close(out)
+
+		// last — позиция последнего отданного комментария, от нее догоняется разрыв шины
+		last := w.Cursor
+		send := func(c model.Comment, missed int) bool {
+			ev := CommentAddedEvent{Comment: c, Cursor: *paginator.Encode(c), Missed: missed}
+			select {
+			case out <- ev:
+				cur := paginator.Key(c)
+				last = &cur
+				return true
+			case <-ctx.Done():
+				return false
+			}
 		}
 
-	default:
-		req, err := toGetRepliesParams(postID, parentID, in)
-		if err != nil {
-			return page, err
+		// replayed — комментарии, отданные при догоне до позиции replayedTo
+		var replayed map[int64]struct{}
+		var replayedTo pagination.Cursor
+		catchUp := func() bool {
+			err := s.replay(ctx, postID, *last, func(c model.Comment) bool {
+				if replayed == nil {
+					replayed = make(map[int64]struct{})
+				}
+				replayed[c.ID] = struct{}{}
+				return send(c, 0)
+			})
+			if err != nil && ctx.Err() == nil {
+				logger.FromContext(ctx).Error("error replaying comments", "error", err, "post_id", postID)
+			}
+			replayedTo = *last
+			return err == nil
+		}
+		if last != nil && !catchUp() {
+			return
 		}
-		req.Limit = peek
 
-		items, err = s.commentStorage.GetRepliesWithCursor(ctx, req)
-		if err != nil {
-			return page, err
+		// потери перед уже отданным комментарием переносятся на следующее событие
+		missed := 0
+		for msg := range live {
+			if msg.Err != nil {
+				stream.err = msg.Err
+				return
+			}
+			missed += msg.Missed
+			if c := msg.Event.Comment; msg.Event.Kind == EventCreated && c != nil {
+				_, dup := replayed[c.ID]
+				if replayed != nil && !dup && createdAfter(*c, replayedTo) {
+					replayed = nil
+				}
+				if !dup {
+					if !send(*c, missed) {
+						return
+					}
+					missed = 0
+				}
+			}
+			if !msg.Gap {
+				continue
+			}
+			// без позиции догнать разрыв нельзя, о нем сообщает Missed
+			if last == nil {
+				missed++
+				continue
+			}
+			if !catchUp() {
+				return
+			}
+			missed = 0
 		}
-	}
+	}()
 
-	if len(items) == 0 {
-		page.Items = nil
-		page.Count = 0
-		page.HasNextPage = false
-		page.StartCursor = nil
-		page.EndCursor = nil
-		return page, nil
-	}
+	return stream, nil
+}
 
-	if len(items) > limit {
-		page.HasNextPage = true
-		items = items[:limit]
+// createdAfter — c создан позже позиции cur в порядке (created_at, id).
+func createdAfter(c model.Comment, cur pagination.Cursor) bool {
+	if !c.CreatedAt.Equal(cur.CreatedAt) {
+		return c.CreatedAt.After(cur.CreatedAt)
 	}
+	return c.ID > cur.ID
+}
 
-	page.Items = items
-	page.Count = len(items)
+// ListenChanges подписывает на создание, правку и удаление комментариев поста.
+func (s *CommentService) ListenChanges(ctx context.Context, postID int64) (*Stream[CommentChange], error) {
+	if err := s.checkPost(ctx, postID); err != nil {
+		return nil, err
+	}
+	return subscribe(ctx, s.eventBus, CommentsTopic(postID), func(e Event) (CommentChange, bool) {
+		if e.Comment == nil {
+			return CommentChange{}, false
+		}
+		return CommentChange{Kind: e.Kind, Comment: *e.Comment}, true
+	})
+}
 
-	startCursor := pagination.Cursor{
-		CreatedAt: items[0].CreatedAt,
-		ID:        items[0].ID,
+// ListenReplies подписывает на новые ответы на комментарий или на всю его ветку.
+func (s *CommentService) ListenReplies(ctx context.Context, commentID int64, includeDescendants bool) (*Stream[model.Comment], error) {
+	if commentID <= 0 {
+		return nil, fmt.Errorf("commentID must be > 0: %w", ErrInvalidRequest)
 	}
-	endCursor := pagination.Cursor{
-		CreatedAt: items[len(items)-1].CreatedAt,
-		ID:        items[len(items)-1].ID,
+	if _, err := s.commentStorage.GetCommentByID(ctx, commentID); err != nil {
+		return nil, err
 	}
 
-	page.StartCursor, page.EndCursor = startCursor.Encode(), endCursor.Encode()
-	return page, nil
+	topic := RepliesTopic(commentID)
+	if includeDescendants {
+		topic = ThreadTopic(commentID)
+	}
+	return subscribe(ctx, s.eventBus, topic, func(e Event) (model.Comment, bool) {
+		if e.Kind != EventCreated || e.Comment == nil {
+			return model.Comment{}, false
+		}
+		return *e.Comment, true
+	})
 }
 
-func (s *CommentService) Listen(ctx context.Context, postID int64) (<-chan model.Comment, error) {
-	if s.commentBus == nil {
-		return nil, fmt.Errorf("no bus configured")
+// replay отдает в emit комментарии поста после cursor в порядке создания.
+func (s *CommentService) replay(ctx context.Context, postID int64, cursor pagination.Cursor, emit func(model.Comment) bool) error {
+	feed := storage.CommentFeed{Sort: model.CommentSortOld, IncludeReplies: true}
+	for {
+		items, err := s.commentStorage.GetCommentsByPostWithCursor(ctx, storage.GetCommentsParams{
+			PostID:    postID,
+			Feed:      feed,
+			Cursor:    cursor,
+			Direction: storage.DirectionAfter,
+			Limit:     MaxCommentsLimit,
+		})
+		if err != nil {
+			return err
+		}
+		for _, c := range items {
+			if !emit(c) {
+				return ctx.Err()
+			}
+		}
+		if len(items) < MaxCommentsLimit {
+			return nil
+		}
+		cursor = CommentCursor(model.CommentSortOld, items[len(items)-1])
 	}
-
-	return s.commentBus.Subscribe(ctx, postID)
 }
@@ -8,6 +8,7 @@ import (
 
 	"myreddit/internal/adapter/out/storage"
 	"myreddit/internal/model"
+	"myreddit/pkg/auth"
 	"myreddit/pkg/pagination"
 
 	"github.com/stretchr/testify/require"
@@ -21,20 +22,29 @@ func TestCommentService_CreateComment(t *testing.T) {
 
 	tests := []struct {
 		name    string
+		userID  int64
 		req     CreateCommentRequest
-		setup   func(ms *MockCommentStorage, mb *MockCommentBus, mp *MockPostStorage)
+		setup   func(ms *MockCommentStorage, mo *MockOutboxStorage, mp *MockPostStorage)
 		wantErr error
 	}{
+		{
+			name:    "unauthenticated",
+			req:     CreateCommentRequest{PostID: 10, Text: "hi"},
+			setup:   func(_ *MockCommentStorage, _ *MockOutboxStorage, _ *MockPostStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
 		{
 			name:    "validation error",
+			userID:  1,
 			req:     CreateCommentRequest{},
-			setup:   func(_ *MockCommentStorage, _ *MockCommentBus, _ *MockPostStorage) {},
+			setup:   func(_ *MockCommentStorage, _ *MockOutboxStorage, _ *MockPostStorage) {},
 			wantErr: ErrInvalidRequest,
 		},
 		{
-			name: "storage error",
-			req:  CreateCommentRequest{PostID: 10, UserID: 1, Text: "hi"},
-			setup: func(ms *MockCommentStorage, _ *MockCommentBus, mp *MockPostStorage) {
+			name:   "storage error",
+			userID: 1,
+			req:    CreateCommentRequest{PostID: 10, Text: "hi"},
+			setup: func(ms *MockCommentStorage, _ *MockOutboxStorage, mp *MockPostStorage) {
 				mp.EXPECT().
 					GetPostByID(gomock.Any(), int64(10)).
 					Return(model.Post{ID: 10, CommentsEnabled: true}, nil)
@@ -46,9 +56,29 @@ func TestCommentService_CreateComment(t *testing.T) {
 			wantErr: errors.New("db fail"),
 		},
 		{
-			name: "success + publish",
-			req:  CreateCommentRequest{PostID: 10, UserID: 2, Text: "ok"},
-			setup: func(ms *MockCommentStorage, mb *MockCommentBus, mp *MockPostStorage) {
+			name:   "outbox error",
+			userID: 1,
+			req:    CreateCommentRequest{PostID: 10, Text: "hi"},
+			setup: func(ms *MockCommentStorage, mo *MockOutboxStorage, mp *MockPostStorage) {
+				mp.EXPECT().
+					GetPostByID(gomock.Any(), int64(10)).
+					Return(model.Post{ID: 10, CommentsEnabled: true}, nil)
+
+				ms.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Return(model.Comment{ID: 5, PostID: 10}, nil)
+
+				mo.EXPECT().
+					AddEvent(gomock.Any(), gomock.Any()).
+					Return(errors.New("outbox fail"))
+			},
+			wantErr: errors.New("outbox fail"),
+		},
+		{
+			name:   "success + outbox event",
+			userID: 2,
+			req:    CreateCommentRequest{PostID: 10, Text: "ok"},
+			setup: func(ms *MockCommentStorage, mo *MockOutboxStorage, mp *MockPostStorage) {
 				c := model.Comment{ID: 5, PostID: 10, UserID: 2, Body: "ok", CreatedAt: now}
 
 				mp.EXPECT().
@@ -59,8 +89,8 @@ func TestCommentService_CreateComment(t *testing.T) {
 					CreateComment(gomock.Any(), CreateCommentRequest{PostID: 10, UserID: 2, Text: "ok"}).
 					Return(c, nil)
 
-				mb.EXPECT().
-					Publish(gomock.Any(), int64(10), c).
+				mo.EXPECT().
+					AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 5}).
 					Return(nil)
 			},
 			wantErr: nil,
@@ -73,17 +103,18 @@ func TestCommentService_CreateComment(t *testing.T) {
 			defer ctrl.Finish()
 
 			ms := NewMockCommentStorage(ctrl)
-			mb := NewMockCommentBus(ctrl)
+			mo := NewMockOutboxStorage(ctrl)
 			mp := NewMockPostStorage(ctrl)
-			tt.setup(ms, mb, mp)
+			tt.setup(ms, mo, mp)
 
-			svc := NewCommentService(ms, mb, mp)
-			got, err := svc.CreateComment(context.Background(), tt.req)
+			svc := NewCommentService(ms, nil, mp, mo, nopTx{}, testCursors, CommentConfig{})
+			ctx := auth.WithUserID(context.Background(), tt.userID)
+			got, err := svc.CreateComment(ctx, tt.req)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
-				if errors.Is(tt.wantErr, ErrInvalidRequest) {
-					require.ErrorIs(t, err, ErrInvalidRequest)
+				if errors.Is(tt.wantErr, ErrInvalidRequest) || errors.Is(tt.wantErr, ErrUnauthenticated) {
+					require.ErrorIs(t, err, tt.wantErr)
 				}
 				return
 			}
@@ -145,7 +176,7 @@ func TestCommentService_GetCommentByID(t *testing.T) {
 			mp := NewMockPostStorage(ctrl)
 			tt.setup(ms)
 
-			svc := NewCommentService(ms, nil, mp)
+			svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
 			got, err := svc.GetCommentByID(context.Background(), tt.commentID)
 
 			if tt.wantErr != nil {
@@ -226,25 +257,25 @@ func TestCommentService_GetCommentsByPost_NoCursors(t *testing.T) {
 			}
 
 			ms.EXPECT().
-				GetCommentsByPost(gomock.Any(), tt.postID, peek).
+				GetCommentsByPost(gomock.Any(), tt.postID, storage.CommentFeed{}, peek).
 				Return(tt.mockItems, nil)
 
 			mp.EXPECT().
 				GetPostByID(gomock.Any(), tt.postID).
 				Return(model.Post{ID: tt.postID, CommentsEnabled: true}, nil)
 
-			svc := NewCommentService(ms, nil, mp)
-			page, err := svc.GetCommentsByPost(context.Background(), tt.req, tt.postID)
+			svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+			page, err := svc.GetCommentsByPost(context.Background(), tt.req, tt.postID, model.CommentSortNew, false)
 			require.NoError(t, err)
 
 			require.Equal(t, tt.expectHasNext, page.HasNextPage)
 			require.Equal(t, tt.expectCount, page.Count)
 
 			if page.Count > 0 {
-				start := pagination.Cursor{CreatedAt: page.Items[0].CreatedAt, ID: page.Items[0].ID}
-				end := pagination.Cursor{CreatedAt: page.Items[len(page.Items)-1].CreatedAt, ID: page.Items[len(page.Items)-1].ID}
-				require.Equal(t, start.Encode(), page.StartCursor)
-				require.Equal(t, end.Encode(), page.EndCursor)
+				start := pagination.Cursor{Kind: pagination.KindComment, Scope: commentsScope(10, false), CreatedAt: page.Items[0].CreatedAt, ID: page.Items[0].ID}
+				end := pagination.Cursor{Kind: pagination.KindComment, Scope: commentsScope(10, false), CreatedAt: page.Items[len(page.Items)-1].CreatedAt, ID: page.Items[len(page.Items)-1].ID}
+				require.Equal(t, testCursors.Encode(start), page.StartCursor)
+				require.Equal(t, testCursors.Encode(end), page.EndCursor)
 			} else {
 				require.Nil(t, page.StartCursor)
 				require.Nil(t, page.EndCursor)
@@ -272,8 +303,8 @@ func TestCommentService_GetCommentsByPost_WithCursor(t *testing.T) {
 			name:   "after cursor",
 			postID: 10,
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 50, CreatedAt: now}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindComment, Scope: commentsScope(10, false), ID: 50, CreatedAt: now}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 2, AfterCursor: enc}
 			}(),
 			setup: func(ms *MockCommentStorage, mp *MockPostStorage, cap *capParams, ret []model.Comment) {
@@ -295,8 +326,8 @@ func TestCommentService_GetCommentsByPost_WithCursor(t *testing.T) {
 			name:   "before cursor",
 			postID: 10,
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 10, CreatedAt: now.Add(-time.Hour)}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindComment, Scope: commentsScope(10, false), ID: 10, CreatedAt: now.Add(-time.Hour)}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 3, BeforeCursor: enc}
 			}(),
 			setup: func(ms *MockCommentStorage, mp *MockPostStorage, cap *capParams, ret []model.Comment) {
@@ -338,9 +369,11 @@ func TestCommentService_GetCommentsByPost_WithCursor(t *testing.T) {
 			}
 
 			tt.setup(ms, mp, cap, ret)
+			// проверка элементов с другой стороны страницы
+			ms.EXPECT().GetCommentsByPostWithCursor(gomock.Any(), gomock.Any()).Return(ret[:1], nil)
 
-			svc := NewCommentService(ms, nil, mp)
-			page, err := svc.GetCommentsByPost(context.Background(), tt.req, tt.postID)
+			svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+			page, err := svc.GetCommentsByPost(context.Background(), tt.req, tt.postID, model.CommentSortNew, false)
 			require.NoError(t, err)
 
 			require.Equal(t, peek, cap.got.Limit)
@@ -348,6 +381,7 @@ func TestCommentService_GetCommentsByPost_WithCursor(t *testing.T) {
 			require.Equal(t, tt.expectDir, cap.got.Direction)
 
 			require.True(t, page.HasNextPage)
+			require.True(t, page.HasPreviousPage)
 			require.Equal(t, tt.expectCount, page.Count)
 			require.NotNil(t, page.StartCursor)
 			require.NotNil(t, page.EndCursor)
@@ -414,11 +448,11 @@ func TestCommentService_GetReplies_NoCursors(t *testing.T) {
 			}
 
 			ms.EXPECT().
-				GetReplies(gomock.Any(), tt.postID, tt.parentID, peek).
+				GetReplies(gomock.Any(), tt.postID, tt.parentID, model.CommentSortNew, peek).
 				Return(tt.mockItems, nil)
 
-			svc := NewCommentService(ms, nil, mp)
-			page, err := svc.GetReplies(context.Background(), tt.req, tt.postID, tt.parentID)
+			svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+			page, err := svc.GetReplies(context.Background(), tt.req, tt.postID, tt.parentID, model.CommentSortNew)
 			require.NoError(t, err)
 			require.Equal(t, tt.expectHasNext, page.HasNextPage)
 			require.Equal(t, tt.expectCount, page.Count)
@@ -447,8 +481,8 @@ func TestCommentService_GetReplies_WithCursor(t *testing.T) {
 			postID:   10,
 			parentID: 1,
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 55, CreatedAt: now}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindComment, Scope: repliesScope(1), ID: 55, CreatedAt: now}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 2, AfterCursor: enc}
 			}(),
 			setup: func(ms *MockCommentStorage, cap *capParams, ret []model.Comment) {
@@ -467,8 +501,8 @@ func TestCommentService_GetReplies_WithCursor(t *testing.T) {
 			postID:   10,
 			parentID: 1,
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 5, CreatedAt: now.Add(-time.Hour)}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindComment, Scope: repliesScope(1), ID: 5, CreatedAt: now.Add(-time.Hour)}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 3, BeforeCursor: enc}
 			}(),
 			setup: func(ms *MockCommentStorage, cap *capParams, ret []model.Comment) {
@@ -511,9 +545,10 @@ func TestCommentService_GetReplies_WithCursor(t *testing.T) {
 				Return(model.Post{ID: tt.postID, CommentsEnabled: true}, nil)
 
 			tt.setup(ms, cap, ret)
+			ms.EXPECT().GetRepliesWithCursor(gomock.Any(), gomock.Any()).Return(ret[:1], nil)
 
-			svc := NewCommentService(ms, nil, mp)
-			page, err := svc.GetReplies(context.Background(), tt.req, tt.postID, tt.parentID)
+			svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+			page, err := svc.GetReplies(context.Background(), tt.req, tt.postID, tt.parentID, model.CommentSortNew)
 			require.NoError(t, err)
 
 			require.Equal(t, peek, cap.got.Limit)
@@ -522,6 +557,7 @@ func TestCommentService_GetReplies_WithCursor(t *testing.T) {
 			require.Equal(t, tt.expectDir, cap.got.Direction)
 
 			require.True(t, page.HasNextPage)
+			require.True(t, page.HasPreviousPage)
 			require.Equal(t, tt.expectCount, page.Count)
 			require.NotNil(t, page.StartCursor)
 			require.NotNil(t, page.EndCursor)
@@ -531,3 +567,555 @@ func TestCommentService_GetReplies_WithCursor(t *testing.T) {
 
 // helper
 func ptrI64(v int64) *int64 { return &v }
+
+func TestCommentService_EditComment(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		userID  int64
+		req     EditCommentRequest
+		setup   func(ms *MockCommentStorage)
+		wantErr error
+	}{
+		{
+			name:    "validation error",
+			userID:  1,
+			req:     EditCommentRequest{CommentID: 5},
+			setup:   func(_ *MockCommentStorage) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "unauthenticated",
+			req:     EditCommentRequest{CommentID: 5, Text: "fixed"},
+			setup:   func(_ *MockCommentStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:   "not author",
+			userID: 2,
+			req:    EditCommentRequest{CommentID: 5, Text: "fixed"},
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1}, nil)
+			},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "deleted comment",
+			userID: 1,
+			req:    EditCommentRequest{CommentID: 5, Text: "fixed"},
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1, DeletedAt: &deletedAt}, nil)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "success",
+			userID: 1,
+			req:    EditCommentRequest{CommentID: 5, Text: "fixed"},
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1, Body: "fxied"}, nil)
+				ms.EXPECT().UpdateComment(gomock.Any(), int64(5), "fixed").
+					Return(model.Comment{ID: 5, PostID: 10, UserID: 1, Body: "fixed"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ms := NewMockCommentStorage(ctrl)
+			tt.setup(ms)
+
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantErr == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicCommentEdited, PostID: 10, CommentID: 5}).Return(nil)
+			}
+
+			svc := NewCommentService(ms, nil, NewMockPostStorage(ctrl), mo, nopTx{}, testCursors, CommentConfig{})
+			got, err := svc.EditComment(auth.WithUserID(context.Background(), tt.userID), tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "fixed", got.Body)
+		})
+	}
+}
+
+func TestCommentService_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		userID  int64
+		setup   func(ms *MockCommentStorage)
+		wantErr error
+	}{
+		{
+			name:   "not author",
+			userID: 2,
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1}, nil)
+			},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "already deleted",
+			userID: 1,
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1, DeletedAt: &now}, nil)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "success",
+			userID: 1,
+			setup: func(ms *MockCommentStorage) {
+				ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, UserID: 1, Body: "text"}, nil)
+				ms.EXPECT().DeleteComment(gomock.Any(), int64(5)).
+					Return(model.Comment{ID: 5, PostID: 10, UserID: 1, DeletedAt: &now}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ms := NewMockCommentStorage(ctrl)
+			tt.setup(ms)
+
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantErr == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicCommentDeleted, PostID: 10, CommentID: 5}).Return(nil)
+			}
+
+			svc := NewCommentService(ms, nil, NewMockPostStorage(ctrl), mo, nopTx{}, testCursors, CommentConfig{})
+			got, err := svc.DeleteComment(auth.WithUserID(context.Background(), tt.userID), 5)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, got.IsDeleted())
+		})
+	}
+}
+
+func TestCommentService_CreateComment_DeletedParent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+
+	now := time.Now()
+	parentID := int64(3)
+
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).
+		Return(model.Post{ID: 10, CommentsEnabled: true}, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), parentID).
+		Return(model.Comment{ID: parentID, PostID: 10, DeletedAt: &now}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	_, err := svc.CreateComment(auth.WithUserID(context.Background(), 1), CreateCommentRequest{
+		PostID: 10, ParentID: &parentID, Text: "reply",
+	})
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestCommentService_CreateComment_ParentFromAnotherPost(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+
+	parentID := int64(3)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).
+		Return(model.Post{ID: 10, CommentsEnabled: true}, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), parentID).
+		Return(model.Comment{ID: parentID, PostID: 20}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	_, err := svc.CreateComment(auth.WithUserID(context.Background(), 1), CreateCommentRequest{
+		PostID: 10, ParentID: &parentID, Text: "reply",
+	})
+	require.ErrorIs(t, err, ErrParentPostMismatch)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestCommentService_CreateComment_MaxDepth(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+
+	parentID := int64(3)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).
+		Return(model.Post{ID: 10, CommentsEnabled: true}, nil).Times(2)
+	ms.EXPECT().GetCommentByID(gomock.Any(), parentID).
+		Return(model.Comment{ID: parentID, PostID: 10, Depth: 2, Path: []int64{1, 2}}, nil).Times(2)
+
+	ctx := auth.WithUserID(context.Background(), 1)
+	req := CreateCommentRequest{PostID: 10, ParentID: &parentID, Text: "reply"}
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{MaxDepth: 2})
+	_, err := svc.CreateComment(ctx, req)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+
+	ms.EXPECT().CreateComment(gomock.Any(), gomock.Any()).
+		Return(model.Comment{ID: 4, PostID: 10, ParentID: &parentID, Depth: 3}, nil)
+	mo := NewMockOutboxStorage(ctrl)
+	mo.EXPECT().AddEvent(gomock.Any(), gomock.Any())
+
+	svc = NewCommentService(ms, nil, mp, mo, nopTx{}, testCursors, CommentConfig{MaxDepth: 3})
+	got, err := svc.CreateComment(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 3, got.Depth)
+}
+
+func TestCommentService_GetAncestors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(7)).
+		Return(model.Comment{ID: 7, Depth: 2, Path: []int64{1, 4}}, nil)
+	ms.EXPECT().GetCommentsByIDs(gomock.Any(), []int64{1, 4}).
+		Return([]model.Comment{{ID: 4, Depth: 1}, {ID: 1, Depth: 0}}, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(1)).
+		Return(model.Comment{ID: 1}, nil)
+
+	svc := NewCommentService(ms, nil, NewMockPostStorage(ctrl), nil, nil, testCursors, CommentConfig{})
+
+	got, err := svc.GetAncestors(context.Background(), 7)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, int64(1), got[0].ID)
+	require.Equal(t, int64(4), got[1].ID)
+
+	// у корневого предков нет
+	got, err = svc.GetAncestors(context.Background(), 1)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = svc.GetAncestors(context.Background(), 0)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestCommentService_GetCommentsByPost_Sorted(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil).Times(2)
+
+	ms.EXPECT().GetCommentsByPost(gomock.Any(), int64(10), storage.CommentFeed{Sort: model.CommentSortBest}, 2).
+		Return([]model.Comment{{ID: 3, BestRank: 0.7}, {ID: 1, BestRank: 0.2}}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	page, err := svc.GetCommentsByPost(context.Background(), pagination.PageRequest{Limit: 1}, 10, model.CommentSortBest, false)
+	require.NoError(t, err)
+	require.True(t, page.HasNextPage)
+
+	end, err := testCursors.Decode(page.EndCursor)
+	require.NoError(t, err)
+	require.Equal(t, pagination.Cursor{Kind: pagination.KindComment, ID: 3, Sort: "best", Rank: 0.7, Scope: commentsScope(10, false)}, *end)
+
+	ms.EXPECT().GetCommentsByPostWithCursor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p storage.GetCommentsParams) ([]model.Comment, error) {
+			require.Equal(t, storage.CommentFeed{Sort: model.CommentSortBest}, p.Feed)
+			require.Equal(t, *end, p.Cursor)
+			return nil, nil
+		}).Times(2)
+	_, err = svc.GetCommentsByPost(context.Background(), pagination.PageRequest{Limit: 1, AfterCursor: page.EndCursor}, 10, model.CommentSortBest, false)
+	require.NoError(t, err)
+
+	// курсор best нельзя использовать для old, курсор корневых — для выдачи с
+	// ответами или для другого поста
+	_, err = svc.GetCommentsByPost(context.Background(), pagination.PageRequest{Limit: 1, AfterCursor: page.EndCursor}, 10, model.CommentSortOld, false)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = svc.GetCommentsByPost(context.Background(), pagination.PageRequest{Limit: 1, AfterCursor: page.EndCursor}, 10, model.CommentSortBest, true)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = svc.GetCommentsByPost(context.Background(), pagination.PageRequest{Limit: 1, AfterCursor: page.EndCursor}, 11, model.CommentSortBest, false)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestCommentService_Listen_Replay(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	at := time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC)
+	from := CommentCursor(model.CommentSortOld, model.Comment{ID: 1, CreatedAt: at})
+	from.Scope = commentsScope(10, true)
+
+	// события шины приходят раньше, чем закончится догон; перед третьим
+	// шина потеряла одно событие
+	live := make(chan EventMessage, 3)
+	live <- EventMessage{Event: createdEvent(model.Comment{ID: 3, PostID: 10, CreatedAt: at.Add(2 * time.Second)}), Missed: 1}
+	// правки в commentAdded не попадают
+	live <- EventMessage{Event: Event{Topic: CommentsTopic(10), Kind: EventEdited, PostID: 10, CommentID: 2, Comment: &model.Comment{ID: 2}}}
+	live <- EventMessage{Event: createdEvent(model.Comment{ID: 4, PostID: 10, CreatedAt: at.Add(3 * time.Second)})}
+	mb.EXPECT().Subscribe(gomock.Any(), "comments:10").Return((<-chan EventMessage)(live), nil)
+
+	ms.EXPECT().GetCommentsByPostWithCursor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p storage.GetCommentsParams) ([]model.Comment, error) {
+			require.Equal(t, storage.CommentFeed{Sort: model.CommentSortOld, IncludeReplies: true}, p.Feed)
+			require.Equal(t, from, p.Cursor)
+			require.Equal(t, storage.DirectionAfter, p.Direction)
+			return []model.Comment{
+				{ID: 2, PostID: 10, CreatedAt: at.Add(time.Second)},
+				{ID: 3, PostID: 10, CreatedAt: at.Add(2 * time.Second)},
+			}, nil
+		})
+
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+
+	svc := NewCommentService(ms, mb, mp, nil, nil, testCursors, CommentConfig{})
+	events, err := svc.Listen(ctx, 10, testCursors.Encode(from))
+	require.NoError(t, err)
+
+	var got, missed []int
+	for len(got) < 3 {
+		select {
+		case ev := <-events.C:
+			got = append(got, int(ev.Comment.ID))
+			missed = append(missed, ev.Missed)
+
+			// курсор события возобновляет подписку с этого комментария
+			cur, err := testCursors.Decode(&ev.Cursor)
+			require.NoError(t, err)
+			want := CommentCursor(model.CommentSortOld, ev.Comment)
+			want.Scope = commentsScope(10, true)
+			require.Equal(t, want, *cur)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("got only %v", got)
+		}
+	}
+	// комментарий 3 пришел и при догоне, и из шины, но отдан один раз;
+	// потеря из его сообщения перенесена на следующее событие
+	require.Equal(t, []int{2, 3, 4}, got)
+	require.Equal(t, []int{0, 0, 1}, missed)
+}
+
+func TestCommentService_Listen_Gap(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+
+	at := time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC)
+	first := model.Comment{ID: 1, PostID: 10, CreatedAt: at}
+
+	// первый разрыв догнать не от чего, второй догоняется от комментария 1
+	live := make(chan EventMessage, 4)
+	live <- EventMessage{Gap: true}
+	live <- EventMessage{Event: createdEvent(first)}
+	live <- EventMessage{Gap: true}
+	live <- EventMessage{Event: createdEvent(model.Comment{ID: 3, PostID: 10, CreatedAt: at.Add(2 * time.Second)})}
+	mb.EXPECT().Subscribe(gomock.Any(), "comments:10").Return((<-chan EventMessage)(live), nil)
+
+	ms.EXPECT().GetCommentsByPostWithCursor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p storage.GetCommentsParams) ([]model.Comment, error) {
+			require.Equal(t, CommentCursor(model.CommentSortOld, first), p.Cursor)
+			return []model.Comment{
+				{ID: 2, PostID: 10, CreatedAt: at.Add(time.Second)},
+				{ID: 3, PostID: 10, CreatedAt: at.Add(2 * time.Second)},
+			}, nil
+		})
+
+	svc := NewCommentService(ms, mb, mp, nil, nil, testCursors, CommentConfig{})
+	events, err := svc.Listen(ctx, 10, nil)
+	require.NoError(t, err)
+
+	var got, missed []int
+	for len(got) < 3 {
+		select {
+		case ev := <-events.C:
+			got = append(got, int(ev.Comment.ID))
+			missed = append(missed, ev.Missed)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("got only %v", got)
+		}
+	}
+	require.Equal(t, []int{1, 2, 3}, got)
+	require.Equal(t, []int{1, 0, 0}, missed)
+
+	// комментарий 3 из шины уже отдан при догоне
+	select {
+	case ev := <-events.C:
+		t.Fatalf("duplicate comment %d", ev.Comment.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCreatedAfter(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC)
+	cur := CommentCursor(model.CommentSortOld, model.Comment{ID: 5, CreatedAt: at})
+
+	require.True(t, createdAfter(model.Comment{ID: 1, CreatedAt: at.Add(time.Second)}, cur))
+	require.True(t, createdAfter(model.Comment{ID: 6, CreatedAt: at}, cur))
+	require.False(t, createdAfter(model.Comment{ID: 5, CreatedAt: at}, cur))
+	require.False(t, createdAfter(model.Comment{ID: 9, CreatedAt: at.Add(-time.Second)}, cur))
+}
+
+func createdEvent(c model.Comment) Event {
+	return Event{Topic: CommentsTopic(c.PostID), Kind: EventCreated, PostID: c.PostID, CommentID: c.ID, Comment: &c}
+}
+
+func TestCommentService_ListenChanges(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mp := NewMockPostStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+	live := make(chan EventMessage, 2)
+	live <- EventMessage{Event: createdEvent(model.Comment{ID: 1, PostID: 10})}
+	live <- EventMessage{Event: Event{Topic: CommentsTopic(10), Kind: EventDeleted, PostID: 10, CommentID: 1, Comment: &model.Comment{ID: 1, PostID: 10}}}
+	close(live)
+	mb.EXPECT().Subscribe(gomock.Any(), "comments:10").Return((<-chan EventMessage)(live), nil)
+
+	svc := NewCommentService(nil, mb, mp, nil, nil, testCursors, CommentConfig{})
+	changes, err := svc.ListenChanges(ctx, 10)
+	require.NoError(t, err)
+
+	var kinds []EventKind
+	for ch := range changes.C {
+		require.Equal(t, int64(1), ch.Comment.ID)
+		kinds = append(kinds, ch.Kind)
+	}
+	require.Equal(t, []EventKind{EventCreated, EventDeleted}, kinds)
+	require.NoError(t, changes.Err())
+
+	// подписка на несуществующий пост
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(11)).Return(model.Post{}, ErrNotFound)
+	_, err = svc.ListenChanges(ctx, 11)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCommentService_ListenReplies(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name               string
+		includeDescendants bool
+		topic              string
+	}{
+		{name: "direct replies", topic: "replies:5"},
+		{name: "whole thread", includeDescendants: true, topic: "thread:5"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ms := NewMockCommentStorage(ctrl)
+			mb := NewMockEventBus(ctrl)
+
+			ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).Return(model.Comment{ID: 5, PostID: 10}, nil)
+			live := make(chan EventMessage, 1)
+			live <- EventMessage{Event: createdEvent(model.Comment{ID: 6, PostID: 10})}
+			close(live)
+			mb.EXPECT().Subscribe(gomock.Any(), tt.topic).Return((<-chan EventMessage)(live), nil)
+
+			svc := NewCommentService(ms, mb, nil, nil, nil, testCursors, CommentConfig{})
+			replies, err := svc.ListenReplies(context.Background(), 5, tt.includeDescendants)
+			require.NoError(t, err)
+			require.Equal(t, int64(6), (<-replies.C).ID)
+		})
+	}
+
+	t.Run("comment not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ms := NewMockCommentStorage(ctrl)
+		ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).Return(model.Comment{}, ErrNotFound)
+
+		svc := NewCommentService(ms, NewMockEventBus(ctrl), nil, nil, nil, testCursors, CommentConfig{})
+		_, err := svc.ListenReplies(context.Background(), 5, false)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestCommentService_Listen_InvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := NewCommentService(nil, NewMockEventBus(ctrl), nil, nil, nil, testCursors, CommentConfig{})
+
+	// курсор выдачи NEW не подходит: догон идет в порядке создания
+	cur := testCursors.Encode(CommentCursor(model.CommentSortNew, model.Comment{ID: 1}))
+	_, err := svc.Listen(context.Background(), 10, cur)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestCommentService_Listen_PostNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// на шину не подписываемся
+	mp := NewMockPostStorage(ctrl)
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{}, ErrNotFound)
+
+	svc := NewCommentService(nil, NewMockEventBus(ctrl), mp, nil, nil, testCursors, CommentConfig{})
+	_, err := svc.Listen(context.Background(), 10, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+}
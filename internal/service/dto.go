@@ -1,159 +1,84 @@
 package service
 
 import (
-	"fmt"
 	"myreddit/internal/adapter/out/storage"
-	"myreddit/pkg/pagination"
+	"myreddit/internal/model"
 )
 
 type CreatePostRequest struct {
-	UserID          int64  `validate:"required,gt=0"`
 	Title           string `validate:"required"`
 	Text            string `validate:"required"`
 	CommentsEnabled bool
 }
 
+// UpdatePostRequest — nil-поля не меняются
+type UpdatePostRequest struct {
+	PostID int64   `validate:"required,gt=0"`
+	Title  *string `validate:"omitnil,min=1"`
+	Text   *string `validate:"omitnil,min=1"`
+}
+
 type CreateCommentRequest struct {
 	PostID   int64 `validate:"required,gt=0"`
 	ParentID *int64
-	UserID   int64  `validate:"required,gt=0"`
-	Text     string `validate:"required"`
+	// UserID проставляется сервисом из контекста
+	UserID int64  `validate:"required,gt=0"`
+	Text   string `validate:"required"`
 }
 
-func validatePagination(in pagination.PageRequest) error {
-	beforeCursorProvided := in.BeforeCursor != nil && *in.BeforeCursor != ""
-	afterCursorProvided := in.AfterCursor != nil && *in.AfterCursor != ""
-
-	if beforeCursorProvided && afterCursorProvided {
-		return fmt.Errorf("both cursors provided: %w", ErrInvalidRequest)
-	}
-	return nil
+type EditCommentRequest struct {
+	CommentID int64  `validate:"required,gt=0"`
+	Text      string `validate:"required"`
 }
 
-func toGetPostsParams(in pagination.PageRequest) (storage.GetPostsParams, error) {
-	if err := validatePagination(in); err != nil {
-		return storage.GetPostsParams{}, err
-	}
-
-	if in.Limit <= 0 {
-		in.Limit = DefaultPostsLimit
-	}
-	in.Limit = min(in.Limit, MaxPostsLimit)
-
-	before, err := pagination.Decode(in.BeforeCursor)
-	if err != nil {
-		return storage.GetPostsParams{}, fmt.Errorf("error decoding before-cursor: %w", err)
-	}
-
-	after, err := pagination.Decode(in.AfterCursor)
-	if err != nil {
-		return storage.GetPostsParams{}, fmt.Errorf("error decoding after-cursor: %w", err)
-	}
-
-	if before == nil && after == nil {
-		return storage.GetPostsParams{}, fmt.Errorf("cursor is required: %w", ErrInvalidRequest)
-	}
-
-	var params storage.GetPostsParams
-	params.Limit = in.Limit
-
-	if before != nil {
-		params.Cursor = *before
-		params.Direction = storage.DirectionBefore
-	} else {
-		params.Cursor = *after
-		params.Direction = storage.DirectionAfter
-	}
-	return params, nil
+// CommentTreeRequest — нулевые MaxDepth и MaxChildren заменяются значениями по умолчанию
+type CommentTreeRequest struct {
+	PostID      int64
+	Sort        model.CommentSort
+	MaxDepth    int
+	MaxChildren int
 }
 
-func toGetCommentsRequest(postID int64, in pagination.PageRequest) (storage.GetCommentsParams, error) {
-	if err := validatePagination(in); err != nil {
-		return storage.GetCommentsParams{}, err
-	}
-
-	if postID <= 0 {
-		return storage.GetCommentsParams{}, fmt.Errorf("postID must be > 0: %w", ErrInvalidRequest)
-	}
-
-	if in.Limit <= 0 {
-		in.Limit = DefaultCommentsLimit
-	}
-	in.Limit = min(in.Limit, MaxCommentsLimit)
-
-	before, err := pagination.Decode(in.BeforeCursor)
-	if err != nil {
-		return storage.GetCommentsParams{}, fmt.Errorf("error decoding before-cursor: %w", err)
-	}
-
-	after, err := pagination.Decode(in.AfterCursor)
-	if err != nil {
-		return storage.GetCommentsParams{}, fmt.Errorf("decoding after-cursor: %w", err)
-	}
-
-	if before == nil && after == nil {
-		return storage.GetCommentsParams{}, fmt.Errorf("cursor is required: %w", ErrInvalidRequest)
-	}
-
-	var params storage.GetCommentsParams
-	params.PostID = postID
-	params.Limit = in.Limit
-
-	if before != nil {
-		params.Cursor = *before
-		params.Direction = storage.DirectionBefore
-	} else {
-		params.Cursor = *after
-		params.Direction = storage.DirectionAfter
-	}
-
-	return params, nil
+// CommentAddedEvent — новый комментарий и курсор, с которого подписку можно возобновить
+type CommentAddedEvent struct {
+	Comment model.Comment
+	Cursor  string
+	// Missed — сколько событий потеряно перед этим из-за медленного чтения
+	Missed int
 }
 
-func toGetRepliesParams(postID, parentID int64, in pagination.PageRequest) (storage.GetRepliesParams, error) {
-	if err := validatePagination(in); err != nil {
-		return storage.GetRepliesParams{}, err
-	}
-
-	if postID <= 0 {
-		return storage.GetRepliesParams{}, fmt.Errorf("postID must be > 0: %w", ErrInvalidRequest)
-	}
-
-	if parentID <= 0 {
-		return storage.GetRepliesParams{}, fmt.Errorf("parentID must be > 0: %w", ErrInvalidRequest)
-	}
+// CommentChange — созданный, измененный или удаленный комментарий
+type CommentChange struct {
+	Kind    EventKind
+	Comment model.Comment
+}
 
-	if in.Limit <= 0 {
-		in.Limit = DefaultCommentsLimit
-	}
-	in.Limit = min(in.Limit, MaxCommentsLimit)
+// PostChange — измененный или удаленный пост; у удаленного заполнен только PostID
+type PostChange struct {
+	Kind   EventKind
+	PostID int64
+	Post   model.Post
+}
 
-	before, err := pagination.Decode(in.BeforeCursor)
-	if err != nil {
-		return storage.GetRepliesParams{}, fmt.Errorf("error decoding before-cursor: %w", err)
-	}
+// VoteRequest — голос за пост или комментарий: 1 — за, -1 — против, 0 — отозвать
+type VoteRequest struct {
+	TargetID int64 `validate:"required,gt=0"`
+	Value    int8  `validate:"oneof=-1 0 1"`
+}
 
-	after, err := pagination.Decode(in.AfterCursor)
-	if err != nil {
-		return storage.GetRepliesParams{}, fmt.Errorf("error decoding after-cursor: %w", err)
+func toGetCommentTreeParams(in CommentTreeRequest) storage.GetCommentTreeParams {
+	if in.MaxDepth <= 0 {
+		in.MaxDepth = DefaultCommentTreeDepth
 	}
-
-	if before == nil && after == nil {
-		return storage.GetRepliesParams{}, fmt.Errorf("cursor is required: %w", ErrInvalidRequest)
+	if in.MaxChildren <= 0 {
+		in.MaxChildren = DefaultCommentTreeChildren
 	}
 
-	var params storage.GetRepliesParams
-	params.PostID = postID
-	params.ParentID = parentID
-	params.Limit = in.Limit
-
-	if before != nil {
-		params.Cursor = *before
-		params.Direction = storage.DirectionBefore
-	} else {
-		params.Cursor = *after
-		params.Direction = storage.DirectionAfter
+	return storage.GetCommentTreeParams{
+		PostID:      in.PostID,
+		Sort:        in.Sort,
+		MaxDepth:    min(in.MaxDepth, MaxCommentTreeDepth),
+		MaxChildren: min(in.MaxChildren, MaxCommentTreeChildren),
+		MaxNodes:    MaxCommentTreeNodes,
 	}
-
-	return params, nil
 }
@@ -1,10 +1,20 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrInvalidRequest = errors.New("invalid request")
-	ErrNotFound       = errors.New("not found")
-	ErrInternalError  = errors.New("internal error")
-	ErrForbidden      = errors.New("action forbidden")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrNotFound        = errors.New("not found")
+	ErrInternalError   = errors.New("internal error")
+	ErrForbidden       = errors.New("action forbidden")
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	// ErrSubscriptionLagged — шина закрыла подписку: подписчик не успевал читать события
+	ErrSubscriptionLagged = errors.New("subscription lagged")
+
+	// ErrParentPostMismatch — родительский комментарий принадлежит другому посту
+	ErrParentPostMismatch = fmt.Errorf("parent comment belongs to another post: %w", ErrInvalidRequest)
 )
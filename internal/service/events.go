@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"myreddit/internal/model"
+	"strconv"
+)
+
+type EventKind string
+
+const (
+	EventCreated EventKind = "created"
+	EventEdited  EventKind = "edited"
+	EventDeleted EventKind = "deleted"
+)
+
+// PostsTopic — новые посты
+const PostsTopic = "posts"
+
+// PostTopic — изменения и удаление поста
+func PostTopic(postID int64) string {
+	return "post:" + strconv.FormatInt(postID, 10)
+}
+
+// CommentsTopic — новые, измененные и удаленные комментарии поста
+func CommentsTopic(postID int64) string {
+	return "comments:" + strconv.FormatInt(postID, 10)
+}
+
+// RepliesTopic — новые прямые ответы на комментарий
+func RepliesTopic(commentID int64) string {
+	return "replies:" + strconv.FormatInt(commentID, 10)
+}
+
+// ThreadTopic — новые комментарии во всей ветке под комментарием
+func ThreadTopic(commentID int64) string {
+	return "thread:" + strconv.FormatInt(commentID, 10)
+}
+
+// Event — событие шины.
+type Event struct {
+	Topic     string
+	Kind      EventKind
+	PostID    int64
+	CommentID int64
+	Post      *model.Post
+	Comment   *model.Comment
+}
+
+// Routes — топики, подписчики которых получают событие.
+func (e Event) Routes() []string {
+	routes := []string{e.Topic}
+	if e.Kind != EventCreated || e.Comment == nil || e.Comment.ParentID == nil {
+		return routes
+	}
+
+	routes = append(routes, RepliesTopic(*e.Comment.ParentID))
+	for _, id := range e.Comment.Path {
+		routes = append(routes, ThreadTopic(id))
+	}
+	return routes
+}
+
+// EventMessage — событие, доставленное подписчику шины
+type EventMessage struct {
+	Event Event
+	// Missed — сколько событий подписчик потерял между предыдущим сообщением и этим
+	Missed int
+	// Gap — после этого сообщения события могли потеряться, сколько — неизвестно
+	Gap bool
+	// Err — почему шина закрывает подписку; такое сообщение последнее, Event пуст
+	Err error
+}
+
+// Stream — поток событий подписки.
+type Stream[T any] struct {
+	C   <-chan T
+	err error
+}
+
+// Err — почему шина закрыла подписку, nil — подписку закрыл ctx. Читается после закрытия C.
+func (s *Stream[T]) Err() error {
+	return s.err
+}
+
+//go:generate mockgen -source=events.go -destination=./event_bus_mock.go -package=service myreddit/internal/service EventBus
+type EventBus interface {
+	Subscribe(ctx context.Context, topic string) (<-chan EventMessage, error)
+	Publish(ctx context.Context, e Event) error
+}
+
+// subscribe подписывает на topic и отдает то, что pick выбрал из событий.
+func subscribe[T any](ctx context.Context, bus EventBus, topic string, pick func(Event) (T, bool)) (*Stream[T], error) {
+	if bus == nil {
+		return nil, fmt.Errorf("no bus configured")
+	}
+	in, err := bus.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan T)
+	stream := &Stream[T]{C: out}
+	go func() {
+		defer close(out)
+
+		for msg := range in {
+			if msg.Err != nil {
+				stream.err = msg.Err
+				return
+			}
+			v, ok := pick(msg.Event)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return stream, nil
+}
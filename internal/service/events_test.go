@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"myreddit/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEvent_Routes(t *testing.T) {
+	t.Parallel()
+
+	parent := int64(2)
+	reply := model.Comment{ID: 3, PostID: 10, ParentID: &parent, Path: []int64{1, 2}}
+
+	created := Event{Topic: CommentsTopic(10), Kind: EventCreated, Comment: &reply}
+	require.Equal(t, []string{"comments:10", "replies:2", "thread:1", "thread:2"}, created.Routes())
+
+	// правки и корневые комментарии по веткам не расходятся
+	edited := Event{Topic: CommentsTopic(10), Kind: EventEdited, Comment: &reply}
+	require.Equal(t, []string{"comments:10"}, edited.Routes())
+
+	root := Event{Topic: CommentsTopic(10), Kind: EventCreated, Comment: &model.Comment{ID: 1, PostID: 10}}
+	require.Equal(t, []string{"comments:10"}, root.Routes())
+}
+
+func TestSubscribe_BusError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mb := NewMockEventBus(ctrl)
+
+	live := make(chan EventMessage, 2)
+	live <- EventMessage{Event: Event{Topic: PostsTopic, Kind: EventCreated, Post: &model.Post{ID: 1}}}
+	live <- EventMessage{Missed: 3, Err: ErrSubscriptionLagged}
+	close(live)
+	mb.EXPECT().Subscribe(gomock.Any(), PostsTopic).Return((<-chan EventMessage)(live), nil)
+
+	stream, err := subscribe(context.Background(), mb, PostsTopic, func(e Event) (int64, bool) { return e.Post.ID, true })
+	require.NoError(t, err)
+
+	var got []int64
+	for id := range stream.C {
+		got = append(got, id)
+	}
+	// сообщение с ошибкой не отдается как событие, а закрывает поток
+	require.Equal(t, []int64{1}, got)
+	require.ErrorIs(t, stream.Err(), ErrSubscriptionLagged)
+}
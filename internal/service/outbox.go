@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"myreddit/internal/model"
+	"myreddit/pkg/logger"
+	"time"
+)
+
+const (
+	DefaultOutboxPollInterval = 200 * time.Millisecond
+	DefaultOutboxBatchSize    = 100
+	DefaultOutboxMaxAttempts  = 10
+
+	outboxRetryMin = time.Second
+	outboxRetryMax = 5 * time.Minute
+)
+
+// errUnknownOutboxTopic — событие, которое релей не умеет доставлять; оно отбрасывается
+var errUnknownOutboxTopic = errors.New("unknown outbox topic")
+
+var commentEventKinds = map[model.OutboxTopic]EventKind{
+	model.OutboxTopicCommentAdded:   EventCreated,
+	model.OutboxTopicCommentEdited:  EventEdited,
+	model.OutboxTopicCommentDeleted: EventDeleted,
+}
+
+//go:generate mockgen -source=outbox.go -destination=./outbox_storage_mock.go -package=service myreddit/internal/service OutboxStorage
+type OutboxStorage interface {
+	// AddEvent вызывается в транзакции с изменением, которое описывает событие
+	AddEvent(ctx context.Context, e model.OutboxEvent) error
+	// FetchDueEvents отдает до limit событий, время доставки которых наступило, старые первыми.
+	FetchDueEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error)
+	DeleteEvent(ctx context.Context, id int64) error
+	// RetryEvent увеличивает Attempts и откладывает доставку до next
+	RetryEvent(ctx context.Context, id int64, next time.Time, lastErr string) error
+}
+
+type OutboxConfig struct {
+	// PollInterval — пауза между опросами пустого outbox
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts — после стольких неудач событие отбрасывается
+	MaxAttempts int
+}
+
+// OutboxRelay доставляет события outbox в шину событий.
+type OutboxRelay struct {
+	outbox         OutboxStorage
+	postStorage    PostStorage
+	commentStorage CommentStorage
+	eventBus       EventBus
+	txManager      TxManager
+	// savepoints открывает вложенную транзакцию (savepoint) на доставку одного события
+	savepoints TxManager
+	cfg        OutboxConfig
+
+	stats     *expvar.Map
+	delivered *expvar.Int
+	failed    *expvar.Int
+	dropped   *expvar.Int
+	// lag — возраст самого старого события последней выборки, секунды
+	lag *expvar.Float
+}
+
+func NewOutboxRelay(outbox OutboxStorage, postStorage PostStorage, commentStorage CommentStorage, eventBus EventBus, txManager, savepoints TxManager, cfg OutboxConfig) *OutboxRelay {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultOutboxPollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultOutboxBatchSize
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultOutboxMaxAttempts
+	}
+
+	r := &OutboxRelay{
+		outbox:         outbox,
+		postStorage:    postStorage,
+		commentStorage: commentStorage,
+		eventBus:       eventBus,
+		txManager:      txManager,
+		savepoints:     savepoints,
+		cfg:            cfg,
+		stats:          new(expvar.Map).Init(),
+		delivered:      new(expvar.Int),
+		failed:         new(expvar.Int),
+		dropped:        new(expvar.Int),
+		lag:            new(expvar.Float),
+	}
+	r.stats.Set("delivered", r.delivered)
+	r.stats.Set("failed", r.failed)
+	r.stats.Set("dropped", r.dropped)
+	r.stats.Set("lag_seconds", r.lag)
+	return r
+}
+
+// Stats — счетчики релея для публикации через expvar
+func (r *OutboxRelay) Stats() *expvar.Map {
+	return r.stats
+}
+
+// Run доставляет события до отмены ctx.
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	for {
+		n, err := r.RelayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error("outbox relay batch failed", "error", err)
+		}
+		if err == nil && n == r.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RelayBatch в одной транзакции выбирает события и доставляет их
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	var n int
+	err := r.txManager.Do(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		events, err := r.outbox.FetchDueEvents(ctx, now, r.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch outbox events: %w", err)
+		}
+		n = len(events)
+
+		r.lag.Set(0)
+		if n > 0 {
+			r.lag.Set(now.Sub(events[0].CreatedAt).Seconds())
+		}
+
+		for _, e := range events {
+			if err := r.handle(ctx, e, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
+// handle доставляет событие и удаляет его
+func (r *OutboxRelay) handle(ctx context.Context, e model.OutboxEvent, now time.Time) error {
+	log := logger.FromContext(ctx)
+
+	deliverErr := r.savepoints.Do(ctx, func(ctx context.Context) error {
+		return r.deliver(ctx, e)
+	})
+	if deliverErr == nil {
+		if err := r.outbox.DeleteEvent(ctx, e.ID); err != nil {
+			return fmt.Errorf("delete outbox event: %w", err)
+		}
+		r.delivered.Add(1)
+		return nil
+	}
+
+	r.failed.Add(1)
+	permanent := errors.Is(deliverErr, ErrNotFound) || errors.Is(deliverErr, errUnknownOutboxTopic)
+	if permanent || e.Attempts+1 >= r.cfg.MaxAttempts {
+		log.Error("dropping outbox event", "error", deliverErr, "event_id", e.ID, "topic", e.Topic, "attempts", e.Attempts+1)
+		if err := r.outbox.DeleteEvent(ctx, e.ID); err != nil {
+			return fmt.Errorf("delete outbox event: %w", err)
+		}
+		r.dropped.Add(1)
+		return nil
+	}
+
+	log.Warn("outbox event delivery failed", "error", deliverErr, "event_id", e.ID, "attempts", e.Attempts+1)
+	if err := r.outbox.RetryEvent(ctx, e.ID, now.Add(outboxRetryDelay(e.Attempts)), deliverErr.Error()); err != nil {
+		return fmt.Errorf("retry outbox event: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRelay) deliver(ctx context.Context, e model.OutboxEvent) error {
+	ev, err := r.event(ctx, e)
+	if err != nil {
+		return err
+	}
+	return r.eventBus.Publish(ctx, ev)
+}
+
+// event перечитывает пост или комментарий события
+func (r *OutboxRelay) event(ctx context.Context, e model.OutboxEvent) (Event, error) {
+	ev := Event{PostID: e.PostID, CommentID: e.CommentID}
+
+	switch e.Topic {
+	case model.OutboxTopicCommentAdded, model.OutboxTopicCommentEdited, model.OutboxTopicCommentDeleted:
+		c, err := r.commentStorage.GetCommentByID(ctx, e.CommentID)
+		if err != nil {
+			return Event{}, err
+		}
+		ev.Topic, ev.Kind, ev.Comment = CommentsTopic(e.PostID), commentEventKinds[e.Topic], &c
+	case model.OutboxTopicPostAdded, model.OutboxTopicPostEdited:
+		p, err := r.postStorage.GetPostByID(ctx, e.PostID)
+		if err != nil {
+			return Event{}, err
+		}
+		ev.Post = &p
+		ev.Topic, ev.Kind = PostsTopic, EventCreated
+		if e.Topic == model.OutboxTopicPostEdited {
+			ev.Topic, ev.Kind = PostTopic(e.PostID), EventEdited
+		}
+	case model.OutboxTopicPostDeleted:
+		ev.Topic, ev.Kind = PostTopic(e.PostID), EventDeleted
+	default:
+		return Event{}, fmt.Errorf("%w: %s", errUnknownOutboxTopic, e.Topic)
+	}
+	return ev, nil
+}
+
+// outboxRetryDelay — задержка перед повтором: удваивается с каждой попыткой до outboxRetryMax.
+func outboxRetryDelay(attempts int) time.Duration {
+	d := outboxRetryMin
+	for i := 0; i < attempts && d < outboxRetryMax; i++ {
+		d *= 2
+	}
+	return min(d, outboxRetryMax)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outbox.go -destination=./outbox_storage_mock.go -package=service myreddit/internal/service OutboxStorage
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	model "myreddit/internal/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxStorage is a mock of OutboxStorage interface.
+type MockOutboxStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxStorageMockRecorder is the mock recorder for MockOutboxStorage.
+type MockOutboxStorageMockRecorder struct {
+	mock *MockOutboxStorage
+}
+
+// NewMockOutboxStorage creates a new mock instance.
+func NewMockOutboxStorage(ctrl *gomock.Controller) *MockOutboxStorage {
+	mock := &MockOutboxStorage{ctrl: ctrl}
+	mock.recorder = &MockOutboxStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxStorage) EXPECT() *MockOutboxStorageMockRecorder {
+	return m.recorder
+}
+
+// AddEvent mocks base method.
+func (m *MockOutboxStorage) AddEvent(ctx context.Context, e model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEvent", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEvent indicates an expected call of AddEvent.
+func (mr *MockOutboxStorageMockRecorder) AddEvent(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockOutboxStorage)(nil).AddEvent), ctx, e)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockOutboxStorage) DeleteEvent(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent.
+func (mr *MockOutboxStorageMockRecorder) DeleteEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockOutboxStorage)(nil).DeleteEvent), ctx, id)
+}
+
+// FetchDueEvents mocks base method.
+func (m *MockOutboxStorage) FetchDueEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueEvents", ctx, now, limit)
+	ret0, _ := ret[0].([]model.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueEvents indicates an expected call of FetchDueEvents.
+func (mr *MockOutboxStorageMockRecorder) FetchDueEvents(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueEvents", reflect.TypeOf((*MockOutboxStorage)(nil).FetchDueEvents), ctx, now, limit)
+}
+
+// RetryEvent mocks base method.
+func (m *MockOutboxStorage) RetryEvent(ctx context.Context, id int64, next time.Time, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryEvent", ctx, id, next, lastErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryEvent indicates an expected call of RetryEvent.
+func (mr *MockOutboxStorageMockRecorder) RetryEvent(ctx, id, next, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryEvent", reflect.TypeOf((*MockOutboxStorage)(nil).RetryEvent), ctx, id, next, lastErr)
+}
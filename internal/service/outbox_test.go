@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"myreddit/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxRelay_RelayBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mo := NewMockOutboxStorage(ctrl)
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	created := time.Now().Add(-3 * time.Second)
+	events := []model.OutboxEvent{
+		{ID: 1, Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 100, CreatedAt: created},
+		{ID: 2, Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 200, Attempts: 2, CreatedAt: created},
+		{ID: 3, Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 300, CreatedAt: created},
+		{ID: 4, Topic: "unknown", CreatedAt: created},
+	}
+	mo.EXPECT().FetchDueEvents(gomock.Any(), gomock.Any(), 5).Return(events, nil)
+
+	// доставлено — удаляется
+	c := model.Comment{ID: 100, PostID: 10}
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(100)).Return(c, nil)
+	mb.EXPECT().Publish(gomock.Any(), Event{Topic: "comments:10", Kind: EventCreated, PostID: 10, CommentID: 100, Comment: &c}).Return(nil)
+	mo.EXPECT().DeleteEvent(gomock.Any(), int64(1)).Return(nil)
+
+	// ошибка шины — повтор через 4с (третья попытка)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(200)).Return(model.Comment{ID: 200}, nil)
+	mb.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("bus down"))
+	mo.EXPECT().RetryEvent(gomock.Any(), int64(2), gomock.Any(), "bus down").
+		DoAndReturn(func(_ context.Context, _ int64, next time.Time, _ string) error {
+			require.WithinDuration(t, time.Now().Add(4*time.Second), next, time.Second)
+			return nil
+		})
+
+	// комментарий пропал и неизвестный топик — событие отбрасывается
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(300)).Return(model.Comment{}, ErrNotFound)
+	mo.EXPECT().DeleteEvent(gomock.Any(), int64(3)).Return(nil)
+	mo.EXPECT().DeleteEvent(gomock.Any(), int64(4)).Return(nil)
+
+	r := NewOutboxRelay(mo, nil, ms, mb, nopTx{}, nopTx{}, OutboxConfig{BatchSize: 5})
+	n, err := r.RelayBatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	require.Equal(t, int64(1), r.delivered.Value())
+	require.Equal(t, int64(3), r.failed.Value())
+	require.Equal(t, int64(2), r.dropped.Value())
+	require.GreaterOrEqual(t, r.lag.Value(), 3.0)
+}
+
+func TestOutboxRelay_MaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mo := NewMockOutboxStorage(ctrl)
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	mo.EXPECT().FetchDueEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.OutboxEvent{{ID: 1, Topic: model.OutboxTopicCommentAdded, PostID: 10, CommentID: 100, Attempts: 2}}, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(100)).Return(model.Comment{ID: 100}, nil)
+	mb.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("bus down"))
+	mo.EXPECT().DeleteEvent(gomock.Any(), int64(1)).Return(nil)
+
+	r := NewOutboxRelay(mo, nil, ms, mb, nopTx{}, nopTx{}, OutboxConfig{MaxAttempts: 3})
+	_, err := r.RelayBatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), r.dropped.Value())
+}
+
+func TestOutboxRelay_StorageErrorRollsBack(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mo := NewMockOutboxStorage(ctrl)
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	mo.EXPECT().FetchDueEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.OutboxEvent{{ID: 1, Topic: model.OutboxTopicCommentAdded, CommentID: 100}}, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(100)).Return(model.Comment{ID: 100}, nil)
+	mb.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+	mo.EXPECT().DeleteEvent(gomock.Any(), int64(1)).Return(errors.New("db fail"))
+
+	r := NewOutboxRelay(mo, nil, ms, mb, nopTx{}, nopTx{}, OutboxConfig{})
+	_, err := r.RelayBatch(context.Background())
+	require.Error(t, err)
+}
+
+func TestOutboxRelay_Events(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mo := NewMockOutboxStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+	ms := NewMockCommentStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	mo.EXPECT().FetchDueEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.OutboxEvent{
+			{ID: 1, Topic: model.OutboxTopicPostAdded, PostID: 10},
+			{ID: 2, Topic: model.OutboxTopicPostEdited, PostID: 10},
+			{ID: 3, Topic: model.OutboxTopicPostDeleted, PostID: 10},
+			{ID: 4, Topic: model.OutboxTopicCommentDeleted, PostID: 10, CommentID: 5},
+		}, nil)
+	mo.EXPECT().DeleteEvent(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	p := model.Post{ID: 10}
+	c := model.Comment{ID: 5, PostID: 10}
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(p, nil).Times(2)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(5)).Return(c, nil)
+
+	// удаленный пост не перечитывается: событие несет только id
+	gomock.InOrder(
+		mb.EXPECT().Publish(gomock.Any(), Event{Topic: PostsTopic, Kind: EventCreated, PostID: 10, Post: &p}),
+		mb.EXPECT().Publish(gomock.Any(), Event{Topic: "post:10", Kind: EventEdited, PostID: 10, Post: &p}),
+		mb.EXPECT().Publish(gomock.Any(), Event{Topic: "post:10", Kind: EventDeleted, PostID: 10}),
+		mb.EXPECT().Publish(gomock.Any(), Event{Topic: "comments:10", Kind: EventDeleted, PostID: 10, CommentID: 5, Comment: &c}),
+	)
+
+	r := NewOutboxRelay(mo, mp, ms, mb, nopTx{}, nopTx{}, OutboxConfig{})
+	n, err := r.RelayBatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	require.Equal(t, int64(4), r.delivered.Value())
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, time.Second, outboxRetryDelay(0))
+	require.Equal(t, 8*time.Second, outboxRetryDelay(3))
+	require.Equal(t, outboxRetryMax, outboxRetryDelay(100))
+}
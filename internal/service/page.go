@@ -0,0 +1,81 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"myreddit/internal/model"
+	"myreddit/pkg/pagination"
+	"time"
+)
+
+// postPaginator — пагинатор ленты постов в порядке sort за окно window
+func (s *PostService) postPaginator(sort model.PostSort, window model.TimeWindow) pagination.Paginator[model.Post] {
+	return pagination.Paginator[model.Post]{
+		Codec:        s.cursors,
+		Key:          func(p model.Post) pagination.Cursor { return PostCursor(sort, p) },
+		Tail:         postTailCursor(sort),
+		Scope:        postsScope(window),
+		DefaultLimit: DefaultPostsLimit,
+		MaxLimit:     MaxPostsLimit,
+	}
+}
+
+// commentPaginator — пагинатор комментариев и ответов в порядке sort
+func (s *CommentService) commentPaginator(sort model.CommentSort, scope string) pagination.Paginator[model.Comment] {
+	return pagination.Paginator[model.Comment]{
+		Codec:        s.cursors,
+		Key:          func(c model.Comment) pagination.Cursor { return CommentCursor(sort, c) },
+		Tail:         commentTailCursor(sort),
+		Scope:        scope,
+		DefaultLimit: DefaultCommentsLimit,
+		MaxLimit:     MaxCommentsLimit,
+	}
+}
+
+// postsScope — scope курсоров ленты постов
+func postsScope(window model.TimeWindow) string {
+	return fmt.Sprintf("window:%d", window)
+}
+
+// commentsScope — scope курсоров комментариев поста
+func commentsScope(postID int64, includeReplies bool) string {
+	if includeReplies {
+		return fmt.Sprintf("post:%d", postID)
+	}
+	return fmt.Sprintf("post:%d:roots", postID)
+}
+
+// repliesScope — scope курсоров ответов на комментарий
+func repliesScope(parentID int64) string {
+	return fmt.Sprintf("parent:%d", parentID)
+}
+
+// pageWindow разбирает запрос страницы; любая ошибка разбора — ошибка запроса.
+func pageWindow[T any](p pagination.Paginator[T], in pagination.PageRequest) (pagination.Window, error) {
+	w, err := p.Window(in)
+	if err != nil {
+		return pagination.Window{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	return w, nil
+}
+
+// maxTime — время позже любого created_at
+var maxTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// postTailCursor — курсор за последним постом ленты: страница до него — хвост ленты.
+func postTailCursor(sort model.PostSort) pagination.Cursor {
+	c := PostCursor(sort, model.Post{})
+	c.Score, c.Rank = math.MinInt64, -math.MaxFloat64
+	return c
+}
+
+// commentTailCursor — курсор за последним комментарием в порядке sort.
+func commentTailCursor(sort model.CommentSort) pagination.Cursor {
+	c := CommentCursor(sort, model.Comment{})
+	if sort == model.CommentSortOld {
+		c.CreatedAt, c.ID = maxTime, math.MaxInt64
+		return c
+	}
+	c.Score, c.Rank = math.MinInt64, -math.MaxFloat64
+	return c
+}
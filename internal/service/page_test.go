@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"myreddit/internal/adapter/out/storage"
+	"myreddit/internal/model"
+	"myreddit/pkg/pagination"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testCursors подписывает курсоры в тестах сервиса
+var testCursors, _ = pagination.NewCodec([]byte("test-secret-test-secret-test-sec"))
+
+func postIDs(items []model.Post) []int64 {
+	out := make([]int64, 0, len(items))
+	for _, p := range items {
+		out = append(out, p.ID)
+	}
+	return out
+}
+
+func TestPageWindow_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	posts := NewPostService(nil, nil, nil, nil, testCursors)
+	comments := NewCommentService(nil, nil, nil, nil, nil, testCursors, CommentConfig{})
+	enc := posts.postPaginator(model.PostSortNew, model.TimeWindowAll).Encode(model.Post{ID: 5})
+
+	_, err := pageWindow(posts.postPaginator(model.PostSortNew, model.TimeWindowAll), pagination.PageRequest{AfterCursor: enc})
+	require.NoError(t, err)
+
+	// курсор постов не принимается выдачей комментариев, курсор NEW — выдачей TOP
+	_, err = pageWindow(comments.commentPaginator(model.CommentSortNew, commentsScope(1, true)), pagination.PageRequest{AfterCursor: enc})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = pageWindow(posts.postPaginator(model.PostSortTop, model.TimeWindowAll), pagination.PageRequest{AfterCursor: enc})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+
+	forged := "eyJDcmVhdGVkQXQiOiIyMDI1LTA5LTI0VDE0OjQ1OjExLjA5MDk0M1oiLCJJRCI6NX0="
+	_, err = pageWindow(posts.postPaginator(model.PostSortNew, model.TimeWindowAll), pagination.PageRequest{AfterCursor: &forged})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = pageWindow(posts.postPaginator(model.PostSortNew, model.TimeWindowAll), pagination.PageRequest{Last: true, AfterCursor: enc})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestPostService_GetPosts_LastAndTotal(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := NewMockPostStorage(ctrl)
+
+	m.EXPECT().GetPostsWithCursor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p storage.GetPostsParams) ([]model.Post, error) {
+			require.Equal(t, storage.DirectionBefore, p.Direction)
+			require.Equal(t, postTailCursor(model.PostSortTop), p.Cursor)
+			require.Equal(t, 3, p.Limit)
+			return []model.Post{{ID: 3}, {ID: 2}, {ID: 1}}, nil
+		})
+	m.EXPECT().CountPosts(gomock.Any(), gomock.Any()).Return(7, nil)
+
+	svc := NewPostService(m, nil, nil, nil, testCursors)
+	page, err := svc.GetPosts(context.Background(), pagination.PageRequest{Limit: 2, Last: true, WithTotal: true}, model.PostSortTop, model.TimeWindowDay)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 1}, postIDs(page.Items))
+	require.True(t, page.HasPreviousPage)
+	require.False(t, page.HasNextPage)
+	require.Equal(t, 7, page.TotalCount)
+}
+
+func TestCommentService_GetReplies_TotalFromReplyCount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ms := NewMockCommentStorage(ctrl)
+	mp := NewMockPostStorage(ctrl)
+
+	mp.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+	ms.EXPECT().GetReplies(gomock.Any(), int64(10), int64(1), model.CommentSortNew, 51).Return(nil, nil)
+	ms.EXPECT().GetCommentByID(gomock.Any(), int64(1)).Return(model.Comment{ID: 1, PostID: 10, ReplyCount: 4}, nil)
+
+	svc := NewCommentService(ms, nil, mp, nil, nil, testCursors, CommentConfig{})
+	page, err := svc.GetReplies(context.Background(), pagination.PageRequest{WithTotal: true}, 10, 1, model.CommentSortNew)
+	require.NoError(t, err)
+	require.Equal(t, 4, page.TotalCount)
+}
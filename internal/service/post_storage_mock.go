@@ -42,6 +42,21 @@ func (m *MockPostStorage) EXPECT() *MockPostStorageMockRecorder {
 	return m.recorder
 }
 
+// CountPosts mocks base method.
+func (m *MockPostStorage) CountPosts(ctx context.Context, feed storage.PostFeed) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPosts", ctx, feed)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPosts indicates an expected call of CountPosts.
+func (mr *MockPostStorageMockRecorder) CountPosts(ctx, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPosts", reflect.TypeOf((*MockPostStorage)(nil).CountPosts), ctx, feed)
+}
+
 // CreatePost mocks base method.
 func (m *MockPostStorage) CreatePost(ctx context.Context, post model.Post) (model.Post, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +72,20 @@ func (mr *MockPostStorageMockRecorder) CreatePost(ctx, post any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockPostStorage)(nil).CreatePost), ctx, post)
 }
 
+// DeletePost mocks base method.
+func (m *MockPostStorage) DeletePost(ctx context.Context, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePost", ctx, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePost indicates an expected call of DeletePost.
+func (mr *MockPostStorageMockRecorder) DeletePost(ctx, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostStorage)(nil).DeletePost), ctx, postID)
+}
+
 // GetPostAuthorID mocks base method.
 func (m *MockPostStorage) GetPostAuthorID(ctx context.Context, postID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -88,18 +117,33 @@ func (mr *MockPostStorageMockRecorder) GetPostByID(ctx, postID any) *gomock.Call
 }
 
 // GetPosts mocks base method.
-func (m *MockPostStorage) GetPosts(ctx context.Context, limit int) ([]model.Post, error) {
+func (m *MockPostStorage) GetPosts(ctx context.Context, feed storage.PostFeed, limit int) ([]model.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPosts", ctx, limit)
+	ret := m.ctrl.Call(m, "GetPosts", ctx, feed, limit)
 	ret0, _ := ret[0].([]model.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPosts indicates an expected call of GetPosts.
-func (mr *MockPostStorageMockRecorder) GetPosts(ctx, limit any) *gomock.Call {
+func (mr *MockPostStorageMockRecorder) GetPosts(ctx, feed, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*MockPostStorage)(nil).GetPosts), ctx, feed, limit)
+}
+
+// GetPostsByIDs mocks base method.
+func (m *MockPostStorage) GetPostsByIDs(ctx context.Context, ids []int64) ([]model.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]model.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByIDs indicates an expected call of GetPostsByIDs.
+func (mr *MockPostStorageMockRecorder) GetPostsByIDs(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*MockPostStorage)(nil).GetPosts), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByIDs", reflect.TypeOf((*MockPostStorage)(nil).GetPostsByIDs), ctx, ids)
 }
 
 // GetPostsWithCursor mocks base method.
@@ -130,3 +174,18 @@ func (mr *MockPostStorageMockRecorder) SetCommentsEnabled(ctx, postID, enabled a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentsEnabled", reflect.TypeOf((*MockPostStorage)(nil).SetCommentsEnabled), ctx, postID, enabled)
 }
+
+// UpdatePost mocks base method.
+func (m *MockPostStorage) UpdatePost(ctx context.Context, params storage.UpdatePostParams) (model.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePost", ctx, params)
+	ret0, _ := ret[0].(model.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePost indicates an expected call of UpdatePost.
+func (mr *MockPostStorageMockRecorder) UpdatePost(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostStorage)(nil).UpdatePost), ctx, params)
+}
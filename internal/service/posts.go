@@ -6,6 +6,7 @@ import (
 	"myreddit/internal/adapter/out/storage"
 	"myreddit/internal/model"
 	"myreddit/pkg/pagination"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -19,32 +20,69 @@ const (
 type PostStorage interface {
 	CreatePost(ctx context.Context, post model.Post) (model.Post, error)
 	GetPostByID(ctx context.Context, postID int64) (model.Post, error)
-	GetPosts(ctx context.Context, limit int) ([]model.Post, error)
+	GetPostsByIDs(ctx context.Context, ids []int64) ([]model.Post, error)
+	GetPosts(ctx context.Context, feed storage.PostFeed, limit int) ([]model.Post, error)
 	GetPostsWithCursor(ctx context.Context, params storage.GetPostsParams) ([]model.Post, error)
+	CountPosts(ctx context.Context, feed storage.PostFeed) (int, error)
 	GetPostAuthorID(ctx context.Context, postID int64) (int64, error)
 	SetCommentsEnabled(ctx context.Context, postID int64, enabled bool) error
+	UpdatePost(ctx context.Context, params storage.UpdatePostParams) (model.Post, error)
+	DeletePost(ctx context.Context, postID int64) error
 }
 
 type PostService struct {
 	postStorage PostStorage
+	eventBus    EventBus
+	outbox      OutboxStorage
+	txManager   TxManager
+	cursors     *pagination.Codec
 }
 
-func NewPostService(postStorage PostStorage) *PostService {
+// NewPostService — eventBus нужен только подпискам
+func NewPostService(postStorage PostStorage, eventBus EventBus, outbox OutboxStorage, txManager TxManager, cursors *pagination.Codec) *PostService {
 	return &PostService{
 		postStorage: postStorage,
+		eventBus:    eventBus,
+		outbox:      outbox,
+		txManager:   txManager,
+		cursors:     cursors,
 	}
 }
 
 func (s *PostService) CreatePost(ctx context.Context, req CreatePostRequest) (model.Post, error) {
+	userID, err := actorID(ctx)
+	if err != nil {
+		return model.Post{}, err
+	}
 	if err := validator.New().Struct(req); err != nil {
-		return model.Post{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		return model.Post{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	var post model.Post
+	err = s.withEvent(ctx, model.OutboxTopicPostAdded, func(ctx context.Context) (int64, error) {
+		var err error
+		post, err = s.postStorage.CreatePost(ctx, model.Post{
+			UserID:          userID,
+			Title:           req.Title,
+			Text:            req.Text,
+			CommentsEnabled: req.CommentsEnabled,
+		})
+		return post.ID, err
+	})
+	if err != nil {
+		return model.Post{}, err
 	}
+	return post, nil
+}
 
-	return s.postStorage.CreatePost(ctx, model.Post{
-		UserID:          req.UserID,
-		Title:           req.Title,
-		Text:            req.Text,
-		CommentsEnabled: req.CommentsEnabled,
+// withEvent выполняет изменение поста и записывает событие в outbox в той же транзакции.
+func (s *PostService) withEvent(ctx context.Context, topic model.OutboxTopic, change func(ctx context.Context) (int64, error)) error {
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		postID, err := change(ctx)
+		if err != nil {
+			return err
+		}
+		return s.outbox.AddEvent(ctx, model.OutboxEvent{Topic: topic, PostID: postID})
 	})
 }
 
@@ -59,80 +97,140 @@ func (s *PostService) GetPostByID(ctx context.Context, postID int64) (model.Post
 	return p, nil
 }
 
-func (s *PostService) GetPosts(ctx context.Context, in pagination.PageRequest) (pagination.Page[model.Post], error) {
-	var (
-		posts []model.Post
-		err   error
-		page  pagination.Page[model.Post]
-	)
-
-	if err := validatePagination(in); err != nil {
-		return page, err
+// GetPostsByIDs отдает найденные посты в произвольном порядке, отсутствующие пропускаются.
+func (s *PostService) GetPostsByIDs(ctx context.Context, ids []int64) ([]model.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
+	return s.postStorage.GetPostsByIDs(ctx, ids)
+}
 
-	limit := in.Limit
-	if limit <= 0 {
-		limit = DefaultPostsLimit
-	}
-	if limit > MaxPostsLimit {
-		limit = MaxPostsLimit
+func (s *PostService) GetPosts(ctx context.Context, in pagination.PageRequest, sort model.PostSort, window model.TimeWindow) (pagination.Page[model.Post], error) {
+	paginator := s.postPaginator(sort, window)
+	w, err := pageWindow(paginator, in)
+	if err != nil {
+		return pagination.Page[model.Post]{}, err
 	}
-	peek := limit + 1
 
-	afterProvided := in.AfterCursor != nil && *in.AfterCursor != ""
-	beforeProvided := in.BeforeCursor != nil && *in.BeforeCursor != ""
+	feed := storage.PostFeed{Sort: sort}
+	if sort == model.PostSortTop {
+		feed.Since = window.Since(time.Now())
+	}
 
-	switch {
-	case !afterProvided && !beforeProvided:
-		posts, err = s.postStorage.GetPosts(ctx, peek)
-		if err != nil {
-			return page, err
+	fetch := func(ctx context.Context, cursor *pagination.Cursor, dir storage.Direction, limit int) ([]model.Post, error) {
+		if cursor == nil {
+			return s.postStorage.GetPosts(ctx, feed, limit)
 		}
+		return s.postStorage.GetPostsWithCursor(ctx, storage.GetPostsParams{
+			Feed:      feed,
+			Cursor:    *cursor,
+			Direction: dir,
+			Limit:     limit,
+		})
+	}
 
-	default:
-		params, err := toGetPostsParams(in)
-		if err != nil {
-			return page, err
-		}
-		params.Limit = peek
-		posts, err = s.postStorage.GetPostsWithCursor(ctx, params)
-		if err != nil {
-			return page, err
+	page, err := paginator.Load(ctx, w, fetch)
+	if err != nil {
+		return page, err
+	}
+	if in.WithTotal {
+		if page.TotalCount, err = s.postStorage.CountPosts(ctx, feed); err != nil {
+			return pagination.Page[model.Post]{}, err
 		}
 	}
+	return page, nil
+}
 
-	if len(posts) == 0 {
-		page.Items = nil
-		page.Count = 0
-		page.HasNextPage = false
-		page.StartCursor = nil
-		page.EndCursor = nil
-		return page, nil
+// PostCursor строит курсор поста для порядка sort
+func PostCursor(sort model.PostSort, p model.Post) pagination.Cursor {
+	c := pagination.Cursor{Kind: pagination.KindPost, CreatedAt: p.CreatedAt, ID: p.ID}
+	switch sort {
+	case model.PostSortHot:
+		c.Sort, c.Rank = sort.String(), p.HotRank
+	case model.PostSortTop:
+		c.Sort, c.Score = sort.String(), p.Score()
+	case model.PostSortControversial:
+		c.Sort, c.Rank = sort.String(), p.Controversy
 	}
+	return c
+}
 
-	if len(posts) > limit {
-		page.HasNextPage = true
-		posts = posts[:limit]
+func (s *PostService) ChangePostCommentPermission(ctx context.Context, postID int64, enabled bool) error {
+	if err := s.checkOwner(ctx, postID); err != nil {
+		return err
 	}
+	return s.withEvent(ctx, model.OutboxTopicPostEdited, func(ctx context.Context) (int64, error) {
+		return postID, s.postStorage.SetCommentsEnabled(ctx, postID, enabled)
+	})
+}
 
-	page.Items = posts
-	page.Count = len(posts)
+func (s *PostService) UpdatePost(ctx context.Context, req UpdatePostRequest) (model.Post, error) {
+	if err := validator.New().Struct(req); err != nil {
+		return model.Post{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	if req.Title == nil && req.Text == nil {
+		return model.Post{}, fmt.Errorf("nothing to update: %w", ErrInvalidRequest)
+	}
+	if err := s.checkOwner(ctx, req.PostID); err != nil {
+		return model.Post{}, err
+	}
 
-	startCursor := pagination.Cursor{
-		CreatedAt: posts[0].CreatedAt,
-		ID:        posts[0].ID,
+	var post model.Post
+	err := s.withEvent(ctx, model.OutboxTopicPostEdited, func(ctx context.Context) (int64, error) {
+		var err error
+		post, err = s.postStorage.UpdatePost(ctx, storage.UpdatePostParams{
+			PostID: req.PostID,
+			Title:  req.Title,
+			Text:   req.Text,
+		})
+		return req.PostID, err
+	})
+	if err != nil {
+		return model.Post{}, err
 	}
-	endCursor := pagination.Cursor{
-		CreatedAt: posts[len(posts)-1].CreatedAt,
-		ID:        posts[len(posts)-1].ID,
+	return post, nil
+}
+
+func (s *PostService) DeletePost(ctx context.Context, postID int64) error {
+	if err := s.checkOwner(ctx, postID); err != nil {
+		return err
 	}
+	return s.withEvent(ctx, model.OutboxTopicPostDeleted, func(ctx context.Context) (int64, error) {
+		return postID, s.postStorage.DeletePost(ctx, postID)
+	})
+}
 
-	page.StartCursor, page.EndCursor = startCursor.Encode(), endCursor.Encode()
-	return page, nil
+// ListenPosts подписывает на новые посты.
+func (s *PostService) ListenPosts(ctx context.Context) (*Stream[model.Post], error) {
+	return subscribe(ctx, s.eventBus, PostsTopic, func(e Event) (model.Post, bool) {
+		if e.Kind != EventCreated || e.Post == nil {
+			return model.Post{}, false
+		}
+		return *e.Post, true
+	})
+}
+
+// ListenPost подписывает на правки и удаление поста; после удаления событий больше не будет.
+func (s *PostService) ListenPost(ctx context.Context, postID int64) (*Stream[PostChange], error) {
+	if _, err := s.GetPostByID(ctx, postID); err != nil {
+		return nil, err
+	}
+	return subscribe(ctx, s.eventBus, PostTopic(postID), func(e Event) (PostChange, bool) {
+		ch := PostChange{Kind: e.Kind, PostID: e.PostID}
+		if e.Post != nil {
+			ch.Post = *e.Post
+		}
+		return ch, e.Post != nil || e.Kind == EventDeleted
+	})
 }
 
-func (s *PostService) ChangePostCommentPermission(ctx context.Context, postID, userID int64, enabled bool) error {
-	if postID <= 0 || userID <= 0 {
+// checkOwner проверяет, что действующий пользователь — автор поста.
+func (s *PostService) checkOwner(ctx context.Context, postID int64) error {
+	userID, err := actorID(ctx)
+	if err != nil {
+		return err
+	}
+	if postID <= 0 {
 		return ErrInvalidRequest
 	}
 	ownerID, err := s.postStorage.GetPostAuthorID(ctx, postID)
@@ -142,5 +240,5 @@ func (s *PostService) ChangePostCommentPermission(ctx context.Context, postID, u
 	if ownerID != userID {
 		return fmt.Errorf("%w: not a post owner", ErrForbidden)
 	}
-	return s.postStorage.SetCommentsEnabled(ctx, postID, enabled)
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"myreddit/internal/adapter/out/storage"
 	"myreddit/internal/model"
+	"myreddit/pkg/auth"
 	"myreddit/pkg/pagination"
 	"testing"
 	"time"
@@ -20,19 +21,29 @@ func TestPostService_CreatePost(t *testing.T) {
 
 	tests := []struct {
 		name    string
+		userID  int64
 		req     CreatePostRequest
 		setup   func(m *MockPostStorage)
 		wantErr error
 	}{
+		{
+			name:    "unauthenticated",
+			userID:  0,
+			req:     CreatePostRequest{Title: "t", Text: "x"},
+			setup:   func(_ *MockPostStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
 		{
 			name:    "validation error",
+			userID:  7,
 			req:     CreatePostRequest{},
 			setup:   func(_ *MockPostStorage) {},
 			wantErr: ErrInvalidRequest,
 		},
 		{
-			name: "storage error",
-			req:  CreatePostRequest{UserID: 7, Title: "t", Text: "x", CommentsEnabled: true},
+			name:   "storage error",
+			userID: 7,
+			req:    CreatePostRequest{Title: "t", Text: "x", CommentsEnabled: true},
 			setup: func(m *MockPostStorage) {
 				m.EXPECT().
 					CreatePost(gomock.Any(), model.Post{
@@ -46,8 +57,9 @@ func TestPostService_CreatePost(t *testing.T) {
 			wantErr: errors.New("db fail"),
 		},
 		{
-			name: "success",
-			req:  CreatePostRequest{UserID: 7, Title: "t", Text: "x", CommentsEnabled: true},
+			name:   "success",
+			userID: 7,
+			req:    CreatePostRequest{Title: "t", Text: "x", CommentsEnabled: true},
 			setup: func(m *MockPostStorage) {
 				m.EXPECT().
 					CreatePost(gomock.Any(), model.Post{
@@ -70,13 +82,19 @@ func TestPostService_CreatePost(t *testing.T) {
 			m := NewMockPostStorage(ctrl)
 			tt.setup(m)
 
-			svc := NewPostService(m)
-			got, err := svc.CreatePost(context.Background(), tt.req)
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantErr == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicPostAdded, PostID: 10}).Return(nil)
+			}
+
+			svc := NewPostService(m, nil, mo, nopTx{}, testCursors)
+			ctx := auth.WithUserID(context.Background(), tt.userID)
+			got, err := svc.CreatePost(ctx, tt.req)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
-				if errors.Is(tt.wantErr, ErrInvalidRequest) {
-					require.ErrorIs(t, err, ErrInvalidRequest)
+				if errors.Is(tt.wantErr, ErrInvalidRequest) || errors.Is(tt.wantErr, ErrUnauthenticated) {
+					require.ErrorIs(t, err, tt.wantErr)
 				}
 				return
 			}
@@ -135,7 +153,7 @@ func TestPostService_GetPostByID(t *testing.T) {
 			m := NewMockPostStorage(ctrl)
 			tt.setup(m)
 
-			svc := NewPostService(m)
+			svc := NewPostService(m, nil, nil, nil, testCursors)
 			got, err := svc.GetPostByID(context.Background(), tt.postID)
 
 			if tt.wantErr != nil {
@@ -210,21 +228,21 @@ func TestPostService_GetPosts_NoCursors(t *testing.T) {
 			}
 
 			m.EXPECT().
-				GetPosts(gomock.Any(), peek).
+				GetPosts(gomock.Any(), storage.PostFeed{}, peek).
 				Return(tt.mockPosts, nil)
 
-			svc := NewPostService(m)
-			page, err := svc.GetPosts(context.Background(), tt.req)
+			svc := NewPostService(m, nil, nil, nil, testCursors)
+			page, err := svc.GetPosts(context.Background(), tt.req, model.PostSortNew, model.TimeWindowAll)
 			require.NoError(t, err)
 			require.Equal(t, tt.expectHasNext, page.HasNextPage)
 			require.Equal(t, tt.expectCount, page.Count)
 
 			if page.Count > 0 {
-				start := pagination.Cursor{CreatedAt: tt.mockPosts[0].CreatedAt, ID: tt.mockPosts[0].ID}
-				end := pagination.Cursor{CreatedAt: tt.mockPosts[min(tt.expectCount-1, len(tt.mockPosts)-1)].CreatedAt, ID: tt.
+				start := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), CreatedAt: tt.mockPosts[0].CreatedAt, ID: tt.mockPosts[0].ID}
+				end := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), CreatedAt: tt.mockPosts[min(tt.expectCount-1, len(tt.mockPosts)-1)].CreatedAt, ID: tt.
 					mockPosts[min(tt.expectCount-1, len(tt.mockPosts)-1)].ID}
-				require.Equal(t, start.Encode(), page.StartCursor)
-				require.Equal(t, end.Encode(), page.EndCursor)
+				require.Equal(t, testCursors.Encode(start), page.StartCursor)
+				require.Equal(t, testCursors.Encode(end), page.EndCursor)
 			} else {
 				require.False(t, page.HasNextPage)
 				require.Nil(t, page.StartCursor)
@@ -253,8 +271,8 @@ func TestPostService_GetPosts_WithCursor(t *testing.T) {
 		{
 			name: "after cursor",
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 100, CreatedAt: now}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), ID: 100, CreatedAt: now}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 2, AfterCursor: enc}
 			}(),
 			setup: func(m *MockPostStorage, cap *capParams, ret []model.Post) {
@@ -270,8 +288,8 @@ func TestPostService_GetPosts_WithCursor(t *testing.T) {
 		{
 			name: "before cursor",
 			req: func() pagination.PageRequest {
-				cur := pagination.Cursor{ID: 50, CreatedAt: now.Add(-time.Hour)}
-				enc := cur.Encode()
+				cur := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), ID: 50, CreatedAt: now.Add(-time.Hour)}
+				enc := testCursors.Encode(cur)
 				return pagination.PageRequest{Limit: 3, BeforeCursor: enc}
 			}(),
 			setup: func(m *MockPostStorage, cap *capParams, ret []model.Post) {
@@ -306,25 +324,97 @@ func TestPostService_GetPosts_WithCursor(t *testing.T) {
 				})
 			}
 			tt.setup(m, cap, ret)
+			// проверка элементов с другой стороны страницы
+			m.EXPECT().GetPostsWithCursor(gomock.Any(), gomock.Any()).Return(ret[:1], nil)
 
-			svc := NewPostService(m)
-			page, err := svc.GetPosts(context.Background(), tt.req)
+			svc := NewPostService(m, nil, nil, nil, testCursors)
+			page, err := svc.GetPosts(context.Background(), tt.req, model.PostSortNew, model.TimeWindowAll)
 			require.NoError(t, err)
 
 			require.Equal(t, peek, cap.got.Limit)
 			require.Equal(t, tt.expectDir, cap.got.Direction)
 
 			require.True(t, page.HasNextPage)
+			require.True(t, page.HasPreviousPage)
 			require.Equal(t, tt.expectCount, page.Count)
 
-			start := pagination.Cursor{CreatedAt: page.Items[0].CreatedAt, ID: page.Items[0].ID}
-			end := pagination.Cursor{CreatedAt: page.Items[len(page.Items)-1].CreatedAt, ID: page.Items[len(page.Items)-1].ID}
-			require.Equal(t, start.Encode(), page.StartCursor)
-			require.Equal(t, end.Encode(), page.EndCursor)
+			start := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), CreatedAt: page.Items[0].CreatedAt, ID: page.Items[0].ID}
+			end := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), CreatedAt: page.Items[len(page.Items)-1].CreatedAt, ID: page.Items[len(page.Items)-1].ID}
+			require.Equal(t, testCursors.Encode(start), page.StartCursor)
+			require.Equal(t, testCursors.Encode(end), page.EndCursor)
 		})
 	}
 }
 
+func TestPostService_GetPosts_Sorted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("top week passes window and returns score cursors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockPostStorage(ctrl)
+
+		m.EXPECT().GetPosts(gomock.Any(), gomock.Any(), 3).
+			DoAndReturn(func(_ context.Context, feed storage.PostFeed, _ int) ([]model.Post, error) {
+				require.Equal(t, model.PostSortTop, feed.Sort)
+				require.WithinDuration(t, now.AddDate(0, 0, -7), feed.Since, time.Minute)
+				return []model.Post{
+					{ID: 4, Upvotes: 9, CreatedAt: now},
+					{ID: 7, Upvotes: 2, CreatedAt: now},
+				}, nil
+			})
+
+		svc := NewPostService(m, nil, nil, nil, testCursors)
+		page, err := svc.GetPosts(context.Background(), pagination.PageRequest{Limit: 2}, model.PostSortTop, model.TimeWindowWeek)
+		require.NoError(t, err)
+
+		end, err := testCursors.Decode(page.EndCursor)
+		require.NoError(t, err)
+		require.Equal(t, "top", end.Sort)
+		require.Equal(t, int64(2), end.Score)
+		require.Equal(t, int64(7), end.ID)
+	})
+
+	t.Run("hot ignores window", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockPostStorage(ctrl)
+
+		m.EXPECT().GetPosts(gomock.Any(), storage.PostFeed{Sort: model.PostSortHot}, 3).Return(nil, nil)
+
+		svc := NewPostService(m, nil, nil, nil, testCursors)
+		_, err := svc.GetPosts(context.Background(), pagination.PageRequest{Limit: 2}, model.PostSortHot, model.TimeWindowDay)
+		require.NoError(t, err)
+	})
+
+	t.Run("cursor of another sort is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		newCursor := pagination.Cursor{Kind: pagination.KindPost, Scope: postsScope(model.TimeWindowAll), ID: 10, CreatedAt: now}
+		hotCursor := PostCursor(model.PostSortHot, model.Post{ID: 10, HotRank: 1.5})
+
+		svc := NewPostService(NewMockPostStorage(ctrl), nil, nil, nil, testCursors)
+		_, err := svc.GetPosts(context.Background(), pagination.PageRequest{AfterCursor: testCursors.Encode(newCursor)}, model.PostSortHot, model.TimeWindowAll)
+		require.ErrorIs(t, err, ErrInvalidRequest)
+
+		_, err = svc.GetPosts(context.Background(), pagination.PageRequest{AfterCursor: testCursors.Encode(hotCursor)}, model.PostSortNew, model.TimeWindowAll)
+		require.ErrorIs(t, err, ErrInvalidRequest)
+	})
+
+	t.Run("cursor of another window is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := NewPostService(NewMockPostStorage(ctrl), nil, nil, nil, testCursors)
+		week := svc.postPaginator(model.PostSortTop, model.TimeWindowWeek).Encode(model.Post{ID: 10, CreatedAt: now})
+		_, err := svc.GetPosts(context.Background(), pagination.PageRequest{AfterCursor: week}, model.PostSortTop, model.TimeWindowDay)
+		require.ErrorIs(t, err, ErrInvalidRequest)
+	})
+}
+
 func TestPostService_ChangePostCommentPermission(t *testing.T) {
 	t.Parallel()
 
@@ -336,6 +426,13 @@ func TestPostService_ChangePostCommentPermission(t *testing.T) {
 		setup     func(m *MockPostStorage)
 		wantError error
 	}{
+		{
+			name:      "unauthenticated",
+			postID:    10,
+			userID:    0,
+			setup:     func(_ *MockPostStorage) {},
+			wantError: ErrUnauthenticated,
+		},
 		{
 			name:      "invalid args",
 			postID:    0,
@@ -393,8 +490,14 @@ func TestPostService_ChangePostCommentPermission(t *testing.T) {
 			m := NewMockPostStorage(ctrl)
 			tt.setup(m)
 
-			svc := NewPostService(m)
-			err := svc.ChangePostCommentPermission(context.Background(), tt.postID, tt.userID, tt.enabled)
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantError == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicPostEdited, PostID: 10}).Return(nil)
+			}
+
+			svc := NewPostService(m, nil, mo, nopTx{}, testCursors)
+			ctx := auth.WithUserID(context.Background(), tt.userID)
+			err := svc.ChangePostCommentPermission(ctx, tt.postID, tt.enabled)
 
 			if tt.wantError != nil {
 				require.Error(t, err)
@@ -402,6 +505,8 @@ func TestPostService_ChangePostCommentPermission(t *testing.T) {
 					require.ErrorIs(t, err, ErrInvalidRequest)
 				} else if errors.Is(tt.wantError, ErrForbidden) {
 					require.ErrorIs(t, err, ErrForbidden)
+				} else if errors.Is(tt.wantError, ErrUnauthenticated) {
+					require.ErrorIs(t, err, ErrUnauthenticated)
 				}
 				return
 			}
@@ -409,3 +514,201 @@ func TestPostService_ChangePostCommentPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestPostService_UpdatePost(t *testing.T) {
+	t.Parallel()
+
+	title := "fixed"
+	empty := ""
+
+	tests := []struct {
+		name    string
+		userID  int64
+		req     UpdatePostRequest
+		setup   func(m *MockPostStorage)
+		wantErr error
+	}{
+		{
+			name:    "nothing to update",
+			userID:  1,
+			req:     UpdatePostRequest{PostID: 10},
+			setup:   func(_ *MockPostStorage) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "empty title",
+			userID:  1,
+			req:     UpdatePostRequest{PostID: 10, Title: &empty},
+			setup:   func(_ *MockPostStorage) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "unauthenticated",
+			req:     UpdatePostRequest{PostID: 10, Title: &title},
+			setup:   func(_ *MockPostStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:   "not owner",
+			userID: 2,
+			req:    UpdatePostRequest{PostID: 10, Title: &title},
+			setup: func(m *MockPostStorage) {
+				m.EXPECT().GetPostAuthorID(gomock.Any(), int64(10)).Return(int64(1), nil)
+			},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "success",
+			userID: 1,
+			req:    UpdatePostRequest{PostID: 10, Title: &title},
+			setup: func(m *MockPostStorage) {
+				m.EXPECT().GetPostAuthorID(gomock.Any(), int64(10)).Return(int64(1), nil)
+				m.EXPECT().
+					UpdatePost(gomock.Any(), storage.UpdatePostParams{PostID: 10, Title: &title}).
+					Return(model.Post{ID: 10, Title: title}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockPostStorage(ctrl)
+			tt.setup(m)
+
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantErr == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicPostEdited, PostID: 10}).Return(nil)
+			}
+
+			svc := NewPostService(m, nil, mo, nopTx{}, testCursors)
+			got, err := svc.UpdatePost(auth.WithUserID(context.Background(), tt.userID), tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, title, got.Title)
+		})
+	}
+}
+
+func TestPostService_DeletePost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  int64
+		setup   func(m *MockPostStorage)
+		wantErr error
+	}{
+		{
+			name:    "unauthenticated",
+			setup:   func(_ *MockPostStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:   "post not found",
+			userID: 1,
+			setup: func(m *MockPostStorage) {
+				m.EXPECT().GetPostAuthorID(gomock.Any(), int64(10)).Return(int64(0), ErrNotFound)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "not owner",
+			userID: 2,
+			setup: func(m *MockPostStorage) {
+				m.EXPECT().GetPostAuthorID(gomock.Any(), int64(10)).Return(int64(1), nil)
+			},
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "success",
+			userID: 1,
+			setup: func(m *MockPostStorage) {
+				m.EXPECT().GetPostAuthorID(gomock.Any(), int64(10)).Return(int64(1), nil)
+				m.EXPECT().DeletePost(gomock.Any(), int64(10)).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockPostStorage(ctrl)
+			tt.setup(m)
+
+			mo := NewMockOutboxStorage(ctrl)
+			if tt.wantErr == nil {
+				mo.EXPECT().AddEvent(gomock.Any(), model.OutboxEvent{Topic: model.OutboxTopicPostDeleted, PostID: 10}).Return(nil)
+			}
+
+			svc := NewPostService(m, nil, mo, nopTx{}, testCursors)
+			err := svc.DeletePost(auth.WithUserID(context.Background(), tt.userID), 10)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPostService_ListenPost(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := NewMockPostStorage(ctrl)
+	mb := NewMockEventBus(ctrl)
+
+	edited := model.Post{ID: 10, Title: "fixed"}
+	live := make(chan EventMessage, 2)
+	live <- EventMessage{Event: Event{Topic: PostTopic(10), Kind: EventEdited, PostID: 10, Post: &edited}}
+	live <- EventMessage{Event: Event{Topic: PostTopic(10), Kind: EventDeleted, PostID: 10}}
+	close(live)
+
+	m.EXPECT().GetPostByID(gomock.Any(), int64(10)).Return(model.Post{ID: 10}, nil)
+	mb.EXPECT().Subscribe(gomock.Any(), "post:10").Return((<-chan EventMessage)(live), nil)
+
+	svc := NewPostService(m, mb, nil, nil, testCursors)
+	changes, err := svc.ListenPost(context.Background(), 10)
+	require.NoError(t, err)
+
+	var got []PostChange
+	for ch := range changes.C {
+		got = append(got, ch)
+	}
+	require.Equal(t, []PostChange{
+		{Kind: EventEdited, PostID: 10, Post: edited},
+		{Kind: EventDeleted, PostID: 10},
+	}, got)
+}
+
+func TestPostService_ListenPosts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mb := NewMockEventBus(ctrl)
+
+	live := make(chan EventMessage, 1)
+	live <- EventMessage{Event: Event{Topic: PostsTopic, Kind: EventCreated, PostID: 11, Post: &model.Post{ID: 11}}}
+	close(live)
+	mb.EXPECT().Subscribe(gomock.Any(), PostsTopic).Return((<-chan EventMessage)(live), nil)
+
+	svc := NewPostService(nil, mb, nil, nil, testCursors)
+	posts, err := svc.ListenPosts(context.Background())
+	require.NoError(t, err)
+
+	p, ok := <-posts.C
+	require.True(t, ok)
+	require.Equal(t, int64(11), p.ID)
+	_, ok = <-posts.C
+	require.False(t, ok)
+}
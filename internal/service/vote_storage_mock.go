@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: votes.go
+//
+// Generated by this command:
+//
+//	mockgen -source=votes.go -destination=./vote_storage_mock.go -package=service myreddit/internal/service VoteStorage,TxManager
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	model "myreddit/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVoteStorage is a mock of VoteStorage interface.
+type MockVoteStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockVoteStorageMockRecorder is the mock recorder for MockVoteStorage.
+type MockVoteStorageMockRecorder struct {
+	mock *MockVoteStorage
+}
+
+// NewMockVoteStorage creates a new mock instance.
+func NewMockVoteStorage(ctrl *gomock.Controller) *MockVoteStorage {
+	mock := &MockVoteStorage{ctrl: ctrl}
+	mock.recorder = &MockVoteStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteStorage) EXPECT() *MockVoteStorageMockRecorder {
+	return m.recorder
+}
+
+// AddVoteCounts mocks base method.
+func (m *MockVoteStorage) AddVoteCounts(ctx context.Context, target model.VoteTarget, targetID, up, down int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVoteCounts", ctx, target, targetID, up, down)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVoteCounts indicates an expected call of AddVoteCounts.
+func (mr *MockVoteStorageMockRecorder) AddVoteCounts(ctx, target, targetID, up, down any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVoteCounts", reflect.TypeOf((*MockVoteStorage)(nil).AddVoteCounts), ctx, target, targetID, up, down)
+}
+
+// GetVote mocks base method.
+func (m *MockVoteStorage) GetVote(ctx context.Context, userID int64, target model.VoteTarget, targetID int64) (int8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVote", ctx, userID, target, targetID)
+	ret0, _ := ret[0].(int8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVote indicates an expected call of GetVote.
+func (mr *MockVoteStorageMockRecorder) GetVote(ctx, userID, target, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVote", reflect.TypeOf((*MockVoteStorage)(nil).GetVote), ctx, userID, target, targetID)
+}
+
+// GetVotes mocks base method.
+func (m *MockVoteStorage) GetVotes(ctx context.Context, userID int64, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVotes", ctx, userID, target, targetIDs)
+	ret0, _ := ret[0].([]model.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVotes indicates an expected call of GetVotes.
+func (mr *MockVoteStorageMockRecorder) GetVotes(ctx, userID, target, targetIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotes", reflect.TypeOf((*MockVoteStorage)(nil).GetVotes), ctx, userID, target, targetIDs)
+}
+
+// SetVote mocks base method.
+func (m *MockVoteStorage) SetVote(ctx context.Context, vote model.Vote) (int8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVote", ctx, vote)
+	ret0, _ := ret[0].(int8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVote indicates an expected call of SetVote.
+func (mr *MockVoteStorageMockRecorder) SetVote(ctx, vote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVote", reflect.TypeOf((*MockVoteStorage)(nil).SetVote), ctx, vote)
+}
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTxManager)(nil).Do), ctx, fn)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"myreddit/internal/model"
+	"myreddit/pkg/auth"
+
+	"github.com/go-playground/validator/v10"
+)
+
+//go:generate mockgen -source=votes.go -destination=./vote_storage_mock.go -package=service myreddit/internal/service VoteStorage,TxManager
+type VoteStorage interface {
+	// SetVote сохраняет голос и возвращает предыдущее значение (0, если голоса не было).
+	SetVote(ctx context.Context, vote model.Vote) (int8, error)
+	GetVote(ctx context.Context, userID int64, target model.VoteTarget, targetID int64) (int8, error)
+	// GetVotes возвращает голоса пользователя за цели targetIDs; целей без голоса в ответе нет.
+	GetVotes(ctx context.Context, userID int64, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error)
+	AddVoteCounts(ctx context.Context, target model.VoteTarget, targetID int64, up, down int64) error
+}
+
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type VoteService struct {
+	voteStorage    VoteStorage
+	postStorage    PostStorage
+	commentStorage CommentStorage
+	txManager      TxManager
+}
+
+func NewVoteService(voteStorage VoteStorage, postStorage PostStorage, commentStorage CommentStorage, txManager TxManager) *VoteService {
+	return &VoteService{
+		voteStorage:    voteStorage,
+		postStorage:    postStorage,
+		commentStorage: commentStorage,
+		txManager:      txManager,
+	}
+}
+
+func (s *VoteService) VotePost(ctx context.Context, req VoteRequest) (model.Post, error) {
+	var out model.Post
+
+	err := s.vote(ctx, model.VoteTargetPost, req, func(ctx context.Context) error {
+		p, err := s.postStorage.GetPostByID(ctx, req.TargetID)
+		if err != nil {
+			return err
+		}
+		out = p
+		return nil
+	})
+	if err != nil {
+		return model.Post{}, err
+	}
+	return out, nil
+}
+
+func (s *VoteService) VoteComment(ctx context.Context, req VoteRequest) (model.Comment, error) {
+	var out model.Comment
+
+	err := s.vote(ctx, model.VoteTargetComment, req, func(ctx context.Context) error {
+		c, err := s.commentStorage.GetCommentByID(ctx, req.TargetID)
+		if err != nil {
+			return err
+		}
+		if c.IsDeleted() {
+			return fmt.Errorf("comment deleted: %w", ErrNotFound)
+		}
+		out = c
+		return nil
+	})
+	if err != nil {
+		return model.Comment{}, err
+	}
+	return out, nil
+}
+
+// vote в одной транзакции сохраняет голос и сдвигает счетчики цели.
+func (s *VoteService) vote(ctx context.Context, target model.VoteTarget, req VoteRequest, load func(ctx context.Context) error) error {
+	userID, err := actorID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validator.New().Struct(req); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := load(ctx); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("%s: %w", target, err)
+			}
+			return err
+		}
+
+		prev, err := s.voteStorage.SetVote(ctx, model.Vote{
+			UserID:     userID,
+			TargetType: target,
+			TargetID:   req.TargetID,
+			Value:      req.Value,
+		})
+		if err != nil {
+			return err
+		}
+
+		up, down := model.VoteDelta(prev, req.Value)
+		if up == 0 && down == 0 {
+			return nil
+		}
+		if err := s.voteStorage.AddVoteCounts(ctx, target, req.TargetID, up, down); err != nil {
+			return err
+		}
+		return load(ctx)
+	})
+}
+
+// ViewerVote возвращает голос текущего пользователя; nil — запрос без пользователя.
+func (s *VoteService) ViewerVote(ctx context.Context, target model.VoteTarget, targetID int64) (*int8, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	v, err := s.voteStorage.GetVote(ctx, userID, target, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ViewerVotes возвращает голоса текущего пользователя за цели targetIDs одним запросом
+func (s *VoteService) ViewerVotes(ctx context.Context, target model.VoteTarget, targetIDs []int64) ([]model.Vote, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return s.voteStorage.GetVotes(ctx, userID, target, targetIDs)
+}
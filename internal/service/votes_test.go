@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"myreddit/internal/model"
+	"myreddit/pkg/auth"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVoteDelta(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		prev, next int8
+		up, down   int64
+	}{
+		{prev: 0, next: 1, up: 1, down: 0},
+		{prev: 0, next: -1, up: 0, down: 1},
+		{prev: 1, next: -1, up: -1, down: 1},
+		{prev: -1, next: 0, up: 0, down: -1},
+		{prev: 1, next: 1, up: 0, down: 0},
+	}
+
+	for _, tt := range tests {
+		up, down := model.VoteDelta(tt.prev, tt.next)
+		require.Equal(t, tt.up, up, "prev=%d next=%d", tt.prev, tt.next)
+		require.Equal(t, tt.down, down, "prev=%d next=%d", tt.prev, tt.next)
+	}
+}
+
+func TestVoteService_VotePost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		userID  int64
+		req     VoteRequest
+		setup   func(mv *MockVoteStorage, mp *MockPostStorage)
+		want    model.Post
+		wantErr error
+	}{
+		{
+			name:    "unauthenticated",
+			req:     VoteRequest{TargetID: 1, Value: 1},
+			setup:   func(_ *MockVoteStorage, _ *MockPostStorage) {},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:    "invalid value",
+			userID:  7,
+			req:     VoteRequest{TargetID: 1, Value: 2},
+			setup:   func(_ *MockVoteStorage, _ *MockPostStorage) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:   "post not found",
+			userID: 7,
+			req:    VoteRequest{TargetID: 1, Value: 1},
+			setup: func(_ *MockVoteStorage, mp *MockPostStorage) {
+				mp.EXPECT().GetPostByID(gomock.Any(), int64(1)).Return(model.Post{}, ErrNotFound)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "upvote to downvote",
+			userID: 7,
+			req:    VoteRequest{TargetID: 1, Value: -1},
+			setup: func(mv *MockVoteStorage, mp *MockPostStorage) {
+				gomock.InOrder(
+					mp.EXPECT().GetPostByID(gomock.Any(), int64(1)).
+						Return(model.Post{ID: 1, Upvotes: 3, Downvotes: 1}, nil),
+					mv.EXPECT().SetVote(gomock.Any(), model.Vote{
+						UserID: 7, TargetType: model.VoteTargetPost, TargetID: 1, Value: -1,
+					}).Return(int8(1), nil),
+					mv.EXPECT().AddVoteCounts(gomock.Any(), model.VoteTargetPost, int64(1), int64(-1), int64(1)).
+						Return(nil),
+					mp.EXPECT().GetPostByID(gomock.Any(), int64(1)).
+						Return(model.Post{ID: 1, Upvotes: 2, Downvotes: 2}, nil),
+				)
+			},
+			want: model.Post{ID: 1, Upvotes: 2, Downvotes: 2},
+		},
+		{
+			name:   "same vote does not touch counters",
+			userID: 7,
+			req:    VoteRequest{TargetID: 1, Value: 1},
+			setup: func(mv *MockVoteStorage, mp *MockPostStorage) {
+				mp.EXPECT().GetPostByID(gomock.Any(), int64(1)).
+					Return(model.Post{ID: 1, Upvotes: 3}, nil)
+				mv.EXPECT().SetVote(gomock.Any(), gomock.Any()).Return(int8(1), nil)
+			},
+			want: model.Post{ID: 1, Upvotes: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mv := NewMockVoteStorage(ctrl)
+			mp := NewMockPostStorage(ctrl)
+			tt.setup(mv, mp)
+
+			svc := NewVoteService(mv, mp, NewMockCommentStorage(ctrl), nopTx{})
+			got, err := svc.VotePost(auth.WithUserID(context.Background(), tt.userID), tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVoteService_VoteComment_Deleted(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	mc := NewMockCommentStorage(ctrl)
+	mc.EXPECT().GetCommentByID(gomock.Any(), int64(5)).
+		Return(model.Comment{ID: 5, DeletedAt: &now}, nil)
+
+	svc := NewVoteService(NewMockVoteStorage(ctrl), NewMockPostStorage(ctrl), mc, nopTx{})
+	_, err := svc.VoteComment(auth.WithUserID(context.Background(), 7), VoteRequest{TargetID: 5, Value: 1})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestVoteService_ViewerVote(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mv := NewMockVoteStorage(ctrl)
+	mv.EXPECT().GetVote(gomock.Any(), int64(7), model.VoteTargetPost, int64(1)).Return(int8(-1), nil)
+
+	svc := NewVoteService(mv, nil, nil, nopTx{})
+
+	got, err := svc.ViewerVote(context.Background(), model.VoteTargetPost, 1)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	got, err = svc.ViewerVote(auth.WithUserID(context.Background(), 7), model.VoteTargetPost, 1)
+	require.NoError(t, err)
+	require.Equal(t, int8(-1), *got)
+}
+
+type nopTx struct{}
+
+func (nopTx) Do(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }
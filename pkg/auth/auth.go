@@ -0,0 +1,17 @@
+package auth
+
+import "context"
+
+type ctxKey struct{}
+
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, ctxKey{}, userID)
+}
+
+func UserIDFromContext(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(ctxKey{}).(int64)
+	if !ok || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformedToken   = errors.New("malformed token")
+	ErrUnsupportedAlg   = errors.New("unsupported token algorithm")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrTokenNotYetValid = errors.New("token not yet valid")
+	ErrMissingExpiry    = errors.New("token has no expiry")
+	ErrWeakSecret       = errors.New("token secret is too short")
+)
+
+const (
+	algHS256 = "HS256"
+	// MinSecretLen — минимальная длина секрета HMAC-SHA256, байт
+	MinSecretLen = 32
+)
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+type claims struct {
+	Sub string `json:"sub"`
+	Exp int64  `json:"exp,omitempty"`
+	Nbf int64  `json:"nbf,omitempty"`
+	Iat int64  `json:"iat,omitempty"`
+}
+
+// TokenManager выпускает и проверяет JWT, подписанные HMAC-SHA256.
+type TokenManager struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewTokenManager отклоняет секреты короче MinSecretLen
+func NewTokenManager(secret []byte) (*TokenManager, error) {
+	if len(secret) < MinSecretLen {
+		return nil, fmt.Errorf("%w: need at least %d bytes", ErrWeakSecret, MinSecretLen)
+	}
+	return &TokenManager{secret: secret, now: time.Now}, nil
+}
+
+// Issue выпускает токен со сроком действия ttl; бессрочные токены не выпускаются.
+func (m *TokenManager) Issue(userID int64, ttl time.Duration) (string, error) {
+	if userID <= 0 {
+		return "", fmt.Errorf("userID must be > 0")
+	}
+	if ttl <= 0 {
+		return "", fmt.Errorf("ttl must be > 0")
+	}
+
+	now := m.now()
+	c := claims{
+		Sub: strconv.FormatInt(userID, 10),
+		Iat: now.Unix(),
+		Exp: now.Add(ttl).Unix(),
+	}
+
+	h, err := json.Marshal(header{Alg: algHS256, Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	p, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(m.sign(signingInput)), nil
+}
+
+// Parse проверяет подпись и срок действия токена и возвращает ID пользователя.
+func (m *TokenManager) Parse(token string) (int64, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, ErrMalformedToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return 0, err
+	}
+	if h.Alg != algHS256 {
+		return 0, ErrUnsupportedAlg
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, ErrMalformedToken
+	}
+	if !hmac.Equal(sig, m.sign(parts[0]+"."+parts[1])) {
+		return 0, ErrInvalidSignature
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return 0, err
+	}
+
+	now := m.now().Unix()
+	if c.Exp == 0 {
+		return 0, ErrMissingExpiry
+	}
+	if now >= c.Exp {
+		return 0, ErrTokenExpired
+	}
+	if c.Nbf != 0 && now < c.Nbf {
+		return 0, ErrTokenNotYetValid
+	}
+
+	userID, err := strconv.ParseInt(c.Sub, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("%w: invalid sub", ErrMalformedToken)
+	}
+	return userID, nil
+}
+
+func (m *TokenManager) sign(signingInput string) []byte {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return ErrMalformedToken
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return ErrMalformedToken
+	}
+	return nil
+}
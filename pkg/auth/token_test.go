@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T, secret string) *TokenManager {
+	t.Helper()
+	m, err := NewTokenManager([]byte(strings.Repeat(secret, MinSecretLen/len(secret)+1)))
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewTokenManager_WeakSecret(t *testing.T) {
+	t.Parallel()
+
+	for _, secret := range []string{"", "change-me", strings.Repeat("x", MinSecretLen-1)} {
+		_, err := NewTokenManager([]byte(secret))
+		require.ErrorIs(t, err, ErrWeakSecret)
+	}
+}
+
+func TestTokenManager_IssueRequiresTTL(t *testing.T) {
+	t.Parallel()
+
+	m := newTestManager(t, "secret")
+	_, err := m.Issue(42, 0)
+	require.Error(t, err)
+}
+
+func TestTokenManager_IssueParse(t *testing.T) {
+	t.Parallel()
+
+	m := newTestManager(t, "secret")
+	other := newTestManager(t, "other")
+
+	valid, err := m.Issue(42, time.Hour)
+	require.NoError(t, err)
+
+	// токен без exp с верной подписью
+	noExpPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"42"}`))
+	noExpInput := strings.Split(valid, ".")[0] + "." + noExpPayload
+	noExp := noExpInput + "." + base64.RawURLEncoding.EncodeToString(m.sign(noExpInput))
+
+	tests := []struct {
+		name    string
+		parser  *TokenManager
+		token   string
+		now     time.Time
+		wantID  int64
+		wantErr error
+	}{
+		{name: "valid", parser: m, token: valid, now: time.Now(), wantID: 42},
+		{name: "wrong secret", parser: other, token: valid, now: time.Now(), wantErr: ErrInvalidSignature},
+		{name: "expired", parser: m, token: valid, now: time.Now().Add(2 * time.Hour), wantErr: ErrTokenExpired},
+		{name: "malformed", parser: m, token: "abc.def", now: time.Now(), wantErr: ErrMalformedToken},
+		{
+			name:    "tampered payload",
+			parser:  m,
+			token:   strings.Join([]string{strings.Split(valid, ".")[0], "eyJzdWIiOiIxIn0", strings.Split(valid, ".")[2]}, "."),
+			now:     time.Now(),
+			wantErr: ErrInvalidSignature,
+		},
+		{name: "no expiry", parser: m, token: noExp, now: time.Now(), wantErr: ErrMissingExpiry},
+		{
+			name:    "alg none",
+			parser:  m,
+			token:   "eyJhbGciOiJub25lIn0.eyJzdWIiOiI0MiJ9.",
+			now:     time.Now(),
+			wantErr: ErrUnsupportedAlg,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := *tt.parser
+			p.now = func() time.Time { return tt.now }
+
+			id, err := p.Parse(tt.token)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, id)
+		})
+	}
+}
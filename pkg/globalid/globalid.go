@@ -0,0 +1,32 @@
+package globalid
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid global id")
+
+// Encode строит непрозрачный глобальный ID объекта: base64("Type:id").
+func Encode(typ string, id int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(typ + ":" + strconv.FormatInt(id, 10)))
+}
+
+// Decode разбирает глобальный ID на тип и числовой id.
+func Decode(s string) (string, int64, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", 0, ErrInvalid
+	}
+	typ, raw, ok := strings.Cut(string(data), ":")
+	if !ok || typ == "" {
+		return "", 0, ErrInvalid
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return "", 0, ErrInvalid
+	}
+	return typ, id, nil
+}
@@ -0,0 +1,38 @@
+package globalid
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	id := Encode("Post", 42)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte("Post:42")), id)
+
+	typ, n, err := Decode(id)
+	require.NoError(t, err)
+	require.Equal(t, "Post", typ)
+	require.Equal(t, int64(42), n)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"",
+		"42",
+		"1234",
+		"not base64!",
+		base64.StdEncoding.EncodeToString([]byte("Post")),
+		base64.StdEncoding.EncodeToString([]byte(":42")),
+		base64.StdEncoding.EncodeToString([]byte("Post:abc")),
+		base64.StdEncoding.EncodeToString([]byte("Post:0")),
+	} {
+		_, _, err := Decode(s)
+		require.ErrorIs(t, err, ErrInvalid, s)
+	}
+}
@@ -1,34 +1,122 @@
 package pagination
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 )
 
+// Виды курсоров: курсор одной выдачи не принимается другой
+const (
+	KindPost    = "post"
+	KindComment = "comment"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrNoCursorKeys  = errors.New("no cursor keys")
+	ErrWeakCursorKey = errors.New("cursor key is too short")
+)
+
+const (
+	// cursorVersion — версия формата, первый байт курсора
+	cursorVersion byte = 1
+	// macLen — длина усеченной подписи HMAC-SHA256
+	macLen = 16
+	// MinKeyLen — минимальная длина секрета подписи курсоров, байт
+	MinKeyLen = 32
+)
+
 type Cursor struct {
-	CreatedAt time.Time
-	ID        int64
+	// Kind — выдача, для которой выдан курсор
+	Kind      string    `json:"k"`
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"i"`
+
+	// Sort — порядок, в котором выдан курсор; пусто — по времени создания
+	Sort string `json:"s,omitempty"`
+	// Scope — выдача внутри вида: окно ленты, пост или родитель
+	Scope string `json:"p,omitempty"`
+	// Score, Rank — ключ сортировки для порядков по счету и рангу
+	Score int64   `json:"sc,omitempty"`
+	Rank  float64 `json:"r,omitempty"`
+}
+
+// Codec подписывает курсоры HMAC-SHA256 и проверяет подпись.
+type Codec struct {
+	// keys[0] подписывает, все — проверяют
+	keys [][]byte
+}
+
+// NewCodec отклоняет секреты короче MinKeyLen
+func NewCodec(keys ...[]byte) (*Codec, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoCursorKeys
+	}
+	for _, k := range keys {
+		if len(k) == 0 {
+			return nil, ErrNoCursorKeys
+		}
+		if len(k) < MinKeyLen {
+			return nil, fmt.Errorf("%w: need at least %d bytes", ErrWeakCursorKey, MinKeyLen)
+		}
+	}
+	return &Codec{keys: keys}, nil
 }
 
-func (c *Cursor) Encode() *string {
-	b, _ := json.Marshal(c)
-	encodedString := base64.StdEncoding.EncodeToString(b)
-	return &encodedString
+func (c *Codec) Encode(cur Cursor) *string {
+	payload, _ := json.Marshal(cur)
+
+	b := make([]byte, 0, 1+len(payload)+macLen)
+	b = append(b, cursorVersion)
+	b = append(b, payload...)
+	b = append(b, sign(c.keys[0], b)...)
+
+	s := base64.RawURLEncoding.EncodeToString(b)
+	return &s
 }
 
-func Decode(s *string) (*Cursor, error) {
+// Decode проверяет версию и подпись курсора; пустой курсор — nil без ошибки.
+func (c *Codec) Decode(s *string) (*Cursor, error) {
 	if s == nil || *s == "" {
 		return nil, nil
 	}
 
-	data, err := base64.StdEncoding.DecodeString(*s)
-	if err != nil {
-		return nil, err
+	b, err := base64.RawURLEncoding.DecodeString(*s)
+	if err != nil || len(b) < 1+macLen {
+		return nil, ErrInvalidCursor
+	}
+	if b[0] != cursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	body, mac := b[:len(b)-macLen], b[len(b)-macLen:]
+	if !c.verify(body, mac) {
+		return nil, ErrInvalidCursor
 	}
-	var c Cursor
-	if err := json.Unmarshal(data, &c); err != nil {
-		return nil, err
+
+	var cur Cursor
+	if err := json.Unmarshal(body[1:], &cur); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cur, nil
+}
+
+func (c *Codec) verify(body, mac []byte) bool {
+	for _, k := range c.keys {
+		if hmac.Equal(sign(k, body), mac) {
+			return true
+		}
 	}
-	return &c, nil
+	return false
+}
+
+func sign(key, body []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(body)
+	return h.Sum(nil)[:macLen]
 }
@@ -0,0 +1,86 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testKey — секрет длиной MinKeyLen
+var testKey = []byte("test-secret-test-secret-test-sec")
+
+func TestCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	codec, err := NewCodec(testKey)
+	require.NoError(t, err)
+
+	cur := Cursor{Kind: KindPost, CreatedAt: time.Date(2025, 9, 24, 12, 0, 0, 0, time.UTC), ID: 42, Sort: "top", Score: -3, Scope: "window:1"}
+	got, err := codec.Decode(codec.Encode(cur))
+	require.NoError(t, err)
+	require.Equal(t, cur, *got)
+
+	got, err = codec.Decode(nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestCodec_Rotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := []byte("old-secret-old-secret-old-secret"), []byte("new-secret-new-secret-new-secret")
+	old, err := NewCodec(oldKey)
+	require.NoError(t, err)
+	rotated, err := NewCodec(newKey, oldKey)
+	require.NoError(t, err)
+	fresh, err := NewCodec(newKey)
+	require.NoError(t, err)
+
+	cur := Cursor{Kind: KindComment, ID: 7}
+
+	// курсор, подписанный старым секретом, принимается во время ротации
+	_, err = rotated.Decode(old.Encode(cur))
+	require.NoError(t, err)
+	// новые курсоры подписываются первым секретом
+	_, err = fresh.Decode(rotated.Encode(cur))
+	require.NoError(t, err)
+	// после удаления старого секрета его курсоры отклоняются
+	_, err = fresh.Decode(old.Encode(cur))
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestCodec_Invalid(t *testing.T) {
+	t.Parallel()
+
+	codec, err := NewCodec(testKey)
+	require.NoError(t, err)
+
+	raw, err := base64.RawURLEncoding.DecodeString(*codec.Encode(Cursor{Kind: KindPost, ID: 1}))
+	require.NoError(t, err)
+
+	tampered := append([]byte(nil), raw...)
+	tampered[len(tampered)-macLen-2] ^= 1
+
+	version := append([]byte(nil), raw...)
+	version[0] = cursorVersion + 1
+
+	for name, s := range map[string]string{
+		"not base64": "!!!",
+		"too short":  base64.RawURLEncoding.EncodeToString(raw[:macLen]),
+		"tampered":   base64.RawURLEncoding.EncodeToString(tampered),
+		"version":    base64.RawURLEncoding.EncodeToString(version),
+		"legacy":     base64.StdEncoding.EncodeToString([]byte(`{"CreatedAt":"2025-09-24T14:45:11Z","ID":5}`)),
+	} {
+		_, err := codec.Decode(&s)
+		require.ErrorIs(t, err, ErrInvalidCursor, name)
+	}
+
+	_, err = NewCodec()
+	require.ErrorIs(t, err, ErrNoCursorKeys)
+	_, err = NewCodec(testKey, nil)
+	require.ErrorIs(t, err, ErrNoCursorKeys)
+	_, err = NewCodec(testKey, []byte("change-me-cursors"))
+	require.ErrorIs(t, err, ErrWeakCursorKey)
+}
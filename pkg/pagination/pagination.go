@@ -4,6 +4,10 @@ type PageRequest struct {
 	BeforeCursor *string
 	AfterCursor  *string
 	Limit        int
+	// Last — отдать Limit последних элементов выдачи (last без before)
+	Last bool
+	// WithTotal — посчитать TotalCount
+	WithTotal bool
 }
 
 type Page[T any] struct {
@@ -11,5 +15,11 @@ type Page[T any] struct {
 	Items       []T
 	StartCursor *string
 	EndCursor   *string
-	HasNextPage bool
+	// Cursors — курсор каждого элемента Items
+	Cursors []string
+	// HasNextPage — есть элементы после EndCursor, HasPreviousPage — до StartCursor
+	HasNextPage     bool
+	HasPreviousPage bool
+	// TotalCount заполняется только при PageRequest.WithTotal
+	TotalCount int
 }
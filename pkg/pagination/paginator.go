@@ -0,0 +1,169 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Direction — направление чтения выдачи относительно курсора
+type Direction int
+
+const (
+	DirectionUnspecified Direction = iota
+	DirectionAfter
+	DirectionBefore
+)
+
+var ErrInvalidPageRequest = errors.New("invalid page request")
+
+// Window — разобранный запрос страницы.
+type Window struct {
+	Cursor    *Cursor
+	Direction Direction
+	Limit     int
+}
+
+// Fetcher читает до limit элементов после (до) cursor и отдает их в порядке выдачи.
+type Fetcher[T any] func(ctx context.Context, cursor *Cursor, dir Direction, limit int) ([]T, error)
+
+// Paginator строит страницы keyset-выдачи элементов T.
+type Paginator[T any] struct {
+	Codec *Codec
+	// Key — курсор элемента.
+	Key func(T) Cursor
+	// Tail — курсор за последним элементом выдачи, от него читается last без before
+	Tail Cursor
+	// Scope подписывается в курсоры страниц и сверяется в Window
+	Scope string
+
+	DefaultLimit int
+	MaxLimit     int
+}
+
+// Window разбирает запрос страницы
+func (p Paginator[T]) Window(in PageRequest) (Window, error) {
+	before, after := in.BeforeCursor != nil && *in.BeforeCursor != "", in.AfterCursor != nil && *in.AfterCursor != ""
+	if before && after {
+		return Window{}, fmt.Errorf("both cursors provided: %w", ErrInvalidPageRequest)
+	}
+	if in.Last && after {
+		return Window{}, fmt.Errorf("last with after cursor: %w", ErrInvalidPageRequest)
+	}
+
+	w := Window{Direction: DirectionAfter, Limit: in.Limit}
+	if w.Limit <= 0 {
+		w.Limit = p.DefaultLimit
+	}
+	w.Limit = min(w.Limit, p.MaxLimit)
+
+	var err error
+	switch {
+	case before:
+		w.Direction = DirectionBefore
+		if w.Cursor, err = p.Codec.Decode(in.BeforeCursor); err != nil {
+			return Window{}, fmt.Errorf("error decoding before-cursor: %w", err)
+		}
+	case after:
+		if w.Cursor, err = p.Codec.Decode(in.AfterCursor); err != nil {
+			return Window{}, fmt.Errorf("error decoding after-cursor: %w", err)
+		}
+	case in.Last:
+		w.Direction = DirectionBefore
+	}
+
+	if w.Cursor != nil {
+		if err := p.accepts(*w.Cursor); err != nil {
+			return Window{}, err
+		}
+	}
+	return w, nil
+}
+
+// accepts отклоняет курсор другой выдачи, порядка или scope
+func (p Paginator[T]) accepts(c Cursor) error {
+	var zero T
+	want := p.Key(zero)
+	if c.Kind != want.Kind {
+		return fmt.Errorf("cursor is not a %s cursor: %w", want.Kind, ErrInvalidCursor)
+	}
+	if c.Sort != want.Sort {
+		return fmt.Errorf("cursor does not match sort: %w", ErrInvalidCursor)
+	}
+	if c.Scope != p.Scope {
+		return fmt.Errorf("cursor does not match scope: %w", ErrInvalidCursor)
+	}
+	return nil
+}
+
+// Encode подписывает курсор элемента выдачи.
+func (p Paginator[T]) Encode(it T) *string {
+	c := p.Key(it)
+	c.Scope = p.Scope
+	return p.Codec.Encode(c)
+}
+
+// Load читает страницу с запасом в один элемент
+func (p Paginator[T]) Load(ctx context.Context, w Window, fetch Fetcher[T]) (Page[T], error) {
+	var page Page[T]
+
+	backward := w.Direction == DirectionBefore
+	from := w.Cursor
+	if from == nil && backward {
+		tail := p.Tail
+		from = &tail
+	}
+
+	items, err := fetch(ctx, from, w.Direction, w.Limit+1)
+	if err != nil {
+		return page, err
+	}
+
+	if len(items) > w.Limit {
+		// при чтении назад элементы приходят в порядке выдачи, лишний — первый
+		if backward {
+			items = items[len(items)-w.Limit:]
+			page.HasPreviousPage = true
+		} else {
+			items = items[:w.Limit]
+			page.HasNextPage = true
+		}
+	}
+
+	if w.Cursor != nil {
+		edge, dir := *w.Cursor, DirectionBefore
+		if backward {
+			dir = DirectionAfter
+		}
+		if len(items) > 0 {
+			if backward {
+				edge = p.Key(items[len(items)-1])
+			} else {
+				edge = p.Key(items[0])
+			}
+		}
+
+		rest, err := fetch(ctx, &edge, dir, 1)
+		if err != nil {
+			return page, err
+		}
+		if backward {
+			page.HasNextPage = len(rest) > 0
+		} else {
+			page.HasPreviousPage = len(rest) > 0
+		}
+	}
+
+	if len(items) == 0 {
+		return page, nil
+	}
+
+	page.Items = items
+	page.Count = len(items)
+	page.Cursors = make([]string, 0, len(items))
+	for _, it := range items {
+		page.Cursors = append(page.Cursors, *p.Encode(it))
+	}
+	page.StartCursor, page.EndCursor = &page.Cursors[0], &page.Cursors[len(page.Cursors)-1]
+	return page, nil
+}
@@ -0,0 +1,122 @@
+package pagination
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPaginator — выдача id от n до 1, курсор — id; хвост — курсор с id 0
+func testPaginator(t *testing.T) Paginator[int64] {
+	codec, err := NewCodec(testKey)
+	require.NoError(t, err)
+	return Paginator[int64]{
+		Codec:        codec,
+		Key:          func(id int64) Cursor { return Cursor{Kind: KindPost, ID: id} },
+		Tail:         Cursor{Kind: KindPost},
+		Scope:        "feed",
+		DefaultLimit: 10,
+		MaxLimit:     50,
+	}
+}
+
+func idFeed(n int64) Fetcher[int64] {
+	return func(_ context.Context, c *Cursor, dir Direction, limit int) ([]int64, error) {
+		out := []int64{}
+		if dir == DirectionAfter {
+			for id := n; id >= 1 && len(out) < limit; id-- {
+				if c == nil || id < c.ID {
+					out = append(out, id)
+				}
+			}
+			return out, nil
+		}
+		for id := int64(1); id <= n && len(out) < limit; id++ {
+			if id > c.ID {
+				out = append([]int64{id}, out...)
+			}
+		}
+		return out, nil
+	}
+}
+
+func TestPaginator_Load(t *testing.T) {
+	t.Parallel()
+
+	at := func(id int64) *Cursor { return &Cursor{Kind: KindPost, ID: id} }
+
+	tests := []struct {
+		name     string
+		w        Window
+		wantIDs  []int64
+		wantNext bool
+		wantPrev bool
+	}{
+		{name: "first page", w: Window{Direction: DirectionAfter, Limit: 3}, wantIDs: []int64{10, 9, 8}, wantNext: true},
+		{name: "after middle", w: Window{Cursor: at(8), Direction: DirectionAfter, Limit: 3}, wantIDs: []int64{7, 6, 5}, wantNext: true, wantPrev: true},
+		{name: "after to end", w: Window{Cursor: at(3), Direction: DirectionAfter, Limit: 3}, wantIDs: []int64{2, 1}, wantPrev: true},
+		{name: "before middle", w: Window{Cursor: at(5), Direction: DirectionBefore, Limit: 3}, wantIDs: []int64{8, 7, 6}, wantNext: true, wantPrev: true},
+		{name: "before to start", w: Window{Cursor: at(8), Direction: DirectionBefore, Limit: 3}, wantIDs: []int64{10, 9}, wantNext: true},
+		{name: "last", w: Window{Direction: DirectionBefore, Limit: 3}, wantIDs: []int64{3, 2, 1}, wantPrev: true},
+		{name: "after last item", w: Window{Cursor: at(1), Direction: DirectionAfter, Limit: 3}, wantPrev: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := testPaginator(t)
+			page, err := p.Load(context.Background(), tt.w, idFeed(10))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantIDs, page.Items)
+			require.Equal(t, tt.wantNext, page.HasNextPage)
+			require.Equal(t, tt.wantPrev, page.HasPreviousPage)
+			require.Equal(t, len(tt.wantIDs), page.Count)
+			require.Len(t, page.Cursors, len(tt.wantIDs))
+
+			if len(tt.wantIDs) > 0 {
+				end, err := p.Codec.Decode(page.EndCursor)
+				require.NoError(t, err)
+				require.Equal(t, tt.wantIDs[len(tt.wantIDs)-1], end.ID)
+				require.Equal(t, "feed", end.Scope)
+			}
+		})
+	}
+}
+
+func TestPaginator_Window(t *testing.T) {
+	t.Parallel()
+
+	p := testPaginator(t)
+	cur := Cursor{Kind: KindPost, ID: 5, Scope: "feed"}
+	enc := p.Codec.Encode(cur)
+
+	w, err := p.Window(PageRequest{Last: true, Limit: 1000})
+	require.NoError(t, err)
+	require.Equal(t, Window{Direction: DirectionBefore, Limit: 50}, w)
+
+	w, err = p.Window(PageRequest{Last: true, BeforeCursor: enc})
+	require.NoError(t, err)
+	require.Equal(t, Window{Cursor: &cur, Direction: DirectionBefore, Limit: 10}, w)
+
+	_, err = p.Window(PageRequest{Last: true, AfterCursor: enc})
+	require.ErrorIs(t, err, ErrInvalidPageRequest)
+	_, err = p.Window(PageRequest{AfterCursor: enc, BeforeCursor: enc})
+	require.ErrorIs(t, err, ErrInvalidPageRequest)
+
+	// курсор другой выдачи и другого порядка не принимается
+	_, err = p.Window(PageRequest{AfterCursor: p.Codec.Encode(Cursor{Kind: KindComment, ID: 5})})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = p.Window(PageRequest{AfterCursor: p.Codec.Encode(Cursor{Kind: KindPost, Sort: "top", ID: 5, Scope: "feed"})})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	// и курсор той же выдачи с другим scope
+	_, err = p.Window(PageRequest{AfterCursor: p.Codec.Encode(Cursor{Kind: KindPost, ID: 5, Scope: "other"})})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = p.Window(PageRequest{AfterCursor: p.Codec.Encode(Cursor{Kind: KindPost, ID: 5})})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	// подписанный другим секретом курсор отклоняется
+	other, err := NewCodec([]byte("other-secret-other-secret-other-s"))
+	require.NoError(t, err)
+	_, err = p.Window(PageRequest{AfterCursor: other.Encode(cur)})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,54 @@
+// Package ranking — формулы ранжирования по голосам (по мотивам reddit).
+package ranking
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// hotEpoch — точка отсчета для hot, сдвигает ранги к небольшим числам
+	hotEpoch = 1134028003
+	// hotHalfLife — за столько секунд ранг вырастает на 1, как от 10x голосов
+	hotHalfLife = 45000
+	// wilsonZ — квантиль нормального распределения для доверия 80%
+	wilsonZ = 1.281551565545
+)
+
+// Hot — ранг, убывающий со временем
+func Hot(ups, downs int64, createdAt time.Time) float64 {
+	score := ups - downs
+	order := math.Log10(math.Max(math.Abs(float64(score)), 1))
+
+	var sign float64
+	switch {
+	case score > 0:
+		sign = 1
+	case score < 0:
+		sign = -1
+	}
+
+	seconds := float64(createdAt.UnixMicro())/1e6 - hotEpoch
+	return sign*order + seconds/hotHalfLife
+}
+
+// Controversy — высокий ранг у постов с большим числом голосов, поделенных примерно поровну.
+func Controversy(ups, downs int64) float64 {
+	if ups <= 0 || downs <= 0 {
+		return 0
+	}
+	magnitude := float64(ups + downs)
+	balance := float64(min(ups, downs)) / float64(max(ups, downs))
+	return math.Pow(magnitude, balance)
+}
+
+// Wilson — нижняя граница доверительного интервала Уилсона для доли голосов «за».
+func Wilson(ups, downs int64) float64 {
+	n := float64(ups + downs)
+	if n <= 0 {
+		return 0
+	}
+	p := float64(ups) / n
+	z2 := wilsonZ * wilsonZ
+	return (p + z2/(2*n) - wilsonZ*math.Sqrt((p*(1-p)+z2/(4*n))/n)) / (1 + z2/n)
+}
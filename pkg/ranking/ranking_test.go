@@ -0,0 +1,56 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHot(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// при равном времени выигрывает больший счет
+	require.Greater(t, Hot(100, 0, now), Hot(10, 0, now))
+	// отрицательный счет опускает пост ниже нулевого
+	require.Less(t, Hot(0, 10, now), Hot(0, 0, now))
+	// свежий пост с меньшим счетом обгоняет старый
+	require.Greater(t, Hot(10, 0, now), Hot(100, 0, now.Add(-24*time.Hour)))
+	// 12.5 часов эквивалентны десятикратному счету
+	require.InDelta(t, Hot(100, 0, now), Hot(10, 0, now.Add(hotHalfLife*time.Second)), 1e-9)
+}
+
+func TestControversy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ups, down int64
+		want      float64
+	}{
+		{name: "no downvotes", ups: 10, down: 0, want: 0},
+		{name: "no upvotes", ups: 0, down: 10, want: 0},
+		{name: "even split", ups: 50, down: 50, want: 100},
+		{name: "uneven split", ups: 30, down: 10, want: 3.4199518933533937},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.want, Controversy(tt.ups, tt.down), 1e-9)
+		})
+	}
+
+	require.Greater(t, Controversy(50, 50), Controversy(90, 10))
+}
+
+func TestWilson(t *testing.T) {
+	t.Parallel()
+
+	require.Zero(t, Wilson(0, 0))
+	require.Greater(t, Wilson(10, 1), Wilson(1, 0))
+	require.Greater(t, Wilson(100, 10), Wilson(10, 1))
+	require.Less(t, Wilson(1, 10), Wilson(1, 1))
+	require.InDelta(t, 0.5, Wilson(1e6, 1e6), 1e-3)
+}
@@ -9,15 +9,53 @@ const (
 	PostUserIDColumn          = "user_id"
 	PostCommentsEnabledColumn = "comments_enabled"
 	PostCreatedAtColumn       = "created_at"
+	PostEditedAtColumn        = "edited_at"
+	PostUpvotesColumn         = "upvotes"
+	PostDownvotesColumn       = "downvotes"
+	PostScoreColumn           = "score"
+	PostHotRankColumn         = "hot_rank"
+	PostControversyColumn     = "controversy"
 )
 
 const (
 	CommentsTableName = "comments"
 
-	CommentIDColumn        = "id"
-	CommentPostIDColumn    = "post_id"
-	CommentParentIDColumn  = "parent_id"
-	CommentUserIDColumn    = "user_id"
-	CommentBodyColumn      = "body"
-	CommentCreatedAtColumn = "created_at"
+	CommentIDColumn          = "id"
+	CommentPostIDColumn      = "post_id"
+	CommentParentIDColumn    = "parent_id"
+	CommentUserIDColumn      = "user_id"
+	CommentBodyColumn        = "body"
+	CommentCreatedAtColumn   = "created_at"
+	CommentEditedAtColumn    = "edited_at"
+	CommentDeletedAtColumn   = "deleted_at"
+	CommentUpvotesColumn     = "upvotes"
+	CommentDownvotesColumn   = "downvotes"
+	CommentScoreColumn       = "score"
+	CommentBestRankColumn    = "best_rank"
+	CommentControversyColumn = "controversy"
+	CommentReplyCountColumn  = "reply_count"
+	CommentPathColumn        = "path"
+	CommentDepthColumn       = "depth"
+)
+
+const (
+	VotesTableName = "votes"
+
+	VoteUserIDColumn     = "user_id"
+	VoteTargetTypeColumn = "target_type"
+	VoteTargetIDColumn   = "target_id"
+	VoteValueColumn      = "value"
+)
+
+const (
+	OutboxTableName = "outbox"
+
+	OutboxIDColumn            = "id"
+	OutboxTopicColumn         = "topic"
+	OutboxPostIDColumn        = "post_id"
+	OutboxCommentIDColumn     = "comment_id"
+	OutboxAttemptsColumn      = "attempts"
+	OutboxLastErrorColumn     = "last_error"
+	OutboxNextAttemptAtColumn = "next_attempt_at"
+	OutboxCreatedAtColumn     = "created_at"
 )